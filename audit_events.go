@@ -0,0 +1,239 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditEventsService handles communication with the audit events related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type AuditEventsService struct {
+	client *Client
+}
+
+// AuditEvent represents an audit event.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type AuditEvent struct {
+	ID         int               `json:"id"`
+	AuthorID   int               `json:"author_id"`
+	EntityID   int               `json:"entity_id"`
+	EntityType string            `json:"entity_type"`
+	Details    AuditEventDetails `json:"details"`
+	CreatedAt  *time.Time        `json:"created_at"`
+}
+
+// AuditEventDetails represents the details of an audit event. The details
+// depend on the kind of event, so only the common keys are exposed as fields.
+// The add, change, from, to and remove values are not always strings, for
+// example setting changes report booleans or numbers, so they are decoded
+// as interface{}. All keys, including the ones without a field, are
+// available in Raw.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type AuditEventDetails struct {
+	With          string      `json:"with"`
+	Add           interface{} `json:"add"`
+	As            string      `json:"as"`
+	Change        interface{} `json:"change"`
+	From          interface{} `json:"from"`
+	To            interface{} `json:"to"`
+	Remove        interface{} `json:"remove"`
+	CustomMessage string      `json:"custom_message"`
+	AuthorName    string      `json:"author_name"`
+	AuthorEmail   string      `json:"author_email"`
+	TargetID      interface{} `json:"target_id"`
+	TargetType    string      `json:"target_type"`
+	TargetDetails string      `json:"target_details"`
+	IPAddress     string      `json:"ip_address"`
+	EntityPath    string      `json:"entity_path"`
+	FailedLogin   string      `json:"failed_login"`
+
+	Raw map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *AuditEventDetails) UnmarshalJSON(data []byte) error {
+	type alias AuditEventDetails
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &d.Raw)
+}
+
+// ListAuditEventsOptions represents the available ListInstanceAuditEvents(),
+// ListGroupAuditEvents() or ListProjectAuditEvents() options. The entity
+// filters are only used when listing instance audit events.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type ListAuditEventsOptions struct {
+	ListOptions
+	CreatedAfter  *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	EntityType    *string    `url:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityID      *int       `url:"entity_id,omitempty" json:"entity_id,omitempty"`
+}
+
+// ListInstanceAuditEvents gets a list of audit events for the instance.
+// Authorization as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-instance-audit-events
+func (s *AuditEventsService) ListInstanceAuditEvents(opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	req, err := s.client.NewRequest("GET", "audit_events", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetInstanceAuditEvent gets a specific instance audit event. Authorization
+// as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-single-instance-audit-event
+func (s *AuditEventsService) GetInstanceAuditEvent(event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	u := fmt.Sprintf("audit_events/%d", event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
+
+// ListGroupAuditEvents gets a list of audit events for the specified group
+// viewable by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-group-audit-events
+func (s *AuditEventsService) ListGroupAuditEvents(gid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/audit_events", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetGroupAuditEvent gets a specific group audit event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-group-audit-event
+func (s *AuditEventsService) GetGroupAuditEvent(gid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/audit_events/%d", pathEscape(group), event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
+
+// ListProjectAuditEvents gets a list of audit events for the specified
+// project viewable by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-project-audit-events
+func (s *AuditEventsService) ListProjectAuditEvents(pid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/audit_events", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetProjectAuditEvent gets a specific project audit event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-project-audit-event
+func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/audit_events/%d", pathEscape(project), event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
@@ -0,0 +1,137 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListInstanceAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/audit_events?created_after=2019-08-30T00%3A00%3A00Z&entity_id=6&entity_type=Project")
+		fmt.Fprint(w, `[{"id":1,"author_id":1,"entity_id":6,"entity_type":"Project","details":{"change":"visibility","from":"Private","to":"Public","author_name":"Administrator","target_id":6,"target_type":"Project","target_details":"flightjs/flight","ip_address":"127.0.0.1","entity_path":"flightjs/flight"},"created_at":"2019-08-30T07:00:41.885Z"}]`)
+	})
+
+	createdAfter := time.Date(2019, 8, 30, 0, 0, 0, 0, time.UTC)
+	opt := &ListAuditEventsOptions{
+		CreatedAfter: &createdAfter,
+		EntityType:   String("Project"),
+		EntityID:     Int(6),
+	}
+	events, _, err := client.AuditEvents.ListInstanceAuditEvents(opt)
+	if err != nil {
+		t.Fatalf("AuditEvents.ListInstanceAuditEvents returned error: %v", err)
+	}
+
+	createdAt := time.Date(2019, 8, 30, 7, 0, 41, 885000000, time.UTC)
+	want := []*AuditEvent{{
+		ID:         1,
+		AuthorID:   1,
+		EntityID:   6,
+		EntityType: "Project",
+		Details: AuditEventDetails{
+			Change:        "visibility",
+			From:          "Private",
+			To:            "Public",
+			AuthorName:    "Administrator",
+			TargetID:      float64(6),
+			TargetType:    "Project",
+			TargetDetails: "flightjs/flight",
+			IPAddress:     "127.0.0.1",
+			EntityPath:    "flightjs/flight",
+			Raw: map[string]interface{}{
+				"change":         "visibility",
+				"from":           "Private",
+				"to":             "Public",
+				"author_name":    "Administrator",
+				"target_id":      float64(6),
+				"target_type":    "Project",
+				"target_details": "flightjs/flight",
+				"ip_address":     "127.0.0.1",
+				"entity_path":    "flightjs/flight",
+			},
+		},
+		CreatedAt: &createdAt,
+	}}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("AuditEvents.ListInstanceAuditEvents returned %+v, want %+v", events, want)
+	}
+}
+
+func TestGetGroupAuditEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/6/audit_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"entity_id":6,"entity_type":"Group","details":{"custom_message":"Group marked for deletion","event_name":"group_deletion_scheduled"}}`)
+	})
+
+	event, _, err := client.AuditEvents.GetGroupAuditEvent(6, 2)
+	if err != nil {
+		t.Fatalf("AuditEvents.GetGroupAuditEvent returned error: %v", err)
+	}
+
+	if event.Details.CustomMessage != "Group marked for deletion" {
+		t.Errorf("Details.CustomMessage is %q, want %q", event.Details.CustomMessage, "Group marked for deletion")
+	}
+	if event.Details.Raw["event_name"] != "group_deletion_scheduled" {
+		t.Errorf("Details.Raw[event_name] is %v, want %q", event.Details.Raw["event_name"], "group_deletion_scheduled")
+	}
+}
+
+func TestListProjectAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":5,"entity_id":7,"entity_type":"Project","details":{"add":"user_access","as":"Developer"}}]`)
+	})
+
+	events, _, err := client.AuditEvents.ListProjectAuditEvents(7, nil)
+	if err != nil {
+		t.Fatalf("AuditEvents.ListProjectAuditEvents returned error: %v", err)
+	}
+
+	if len(events) != 1 || events[0].Details.Add != "user_access" || events[0].Details.As != "Developer" {
+		t.Errorf("AuditEvents.ListProjectAuditEvents returned %+v", events)
+	}
+}
+
+func TestListGroupAuditEventsWithNonStringChanges(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/60/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"entity_id":60,"entity_type":"Group","details":{"change":"require_two_factor_authentication","from":false,"to":true}},
+			{"id":2,"entity_id":60,"entity_type":"Group","details":{"change":"two_factor_grace_period","from":48,"to":24}}
+		]`)
+	})
+
+	events, _, err := client.AuditEvents.ListGroupAuditEvents(60, nil)
+	if err != nil {
+		t.Fatalf("AuditEvents.ListGroupAuditEvents returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("AuditEvents.ListGroupAuditEvents returned %d events, want 2", len(events))
+	}
+	if events[0].Details.From != false || events[0].Details.To != true {
+		t.Errorf("Details from/to are %v/%v, want false/true", events[0].Details.From, events[0].Details.To)
+	}
+	if events[1].Details.From != float64(48) || events[1].Details.To != float64(24) {
+		t.Errorf("Details from/to are %v/%v, want 48/24", events[1].Details.From, events[1].Details.To)
+	}
+	if events[1].Details.Change != "two_factor_grace_period" {
+		t.Errorf("Details.Change is %v, want %q", events[1].Details.Change, "two_factor_grace_period")
+	}
+}
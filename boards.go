@@ -36,6 +36,9 @@ type IssueBoard struct {
 	Name      string       `json:"name"`
 	Project   *Project     `json:"project"`
 	Milestone *Milestone   `json:"milestone"`
+	Assignee  *BasicUser   `json:"assignee"`
+	Labels    []*Label     `json:"labels"`
+	Weight    int          `json:"weight"`
 	Lists     []*BoardList `json:"lists"`
 }
 
@@ -47,9 +50,14 @@ func (b IssueBoard) String() string {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html
 type BoardList struct {
-	ID       int    `json:"id"`
-	Label    *Label `json:"label"`
-	Position int    `json:"position"`
+	ID             int        `json:"id"`
+	Label          *Label     `json:"label"`
+	Assignee       *BasicUser `json:"assignee"`
+	Milestone      *Milestone `json:"milestone"`
+	Position       int        `json:"position"`
+	MaxIssueCount  int        `json:"max_issue_count"`
+	MaxIssueWeight int        `json:"max_issue_weight"`
+	LimitMetric    string     `json:"limit_metric"`
 }
 
 func (b BoardList) String() string {
@@ -60,7 +68,7 @@ func (b BoardList) String() string {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#create-a-board-starter
 type CreateIssueBoardOptions struct {
-	Name *string `url:"name" json:"name"`
+	Name *string `url:"name,omitempty" json:"name,omitempty"`
 }
 
 // CreateIssueBoard creates a new issue board.
@@ -256,10 +264,14 @@ func (s *IssueBoardsService) GetIssueBoardList(pid interface{}, board, list int,
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html#new-board-list
 type CreateIssueBoardListOptions struct {
-	LabelID *int `url:"label_id" json:"label_id"`
+	LabelID     *int `url:"label_id,omitempty" json:"label_id,omitempty"`
+	AssigneeID  *int `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	MilestoneID *int `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
 }
 
-// CreateIssueBoardList creates a new issue board list.
+// CreateIssueBoardList creates a new issue board list. Only one of LabelID,
+// AssigneeID or MilestoneID should be set. Assignee and milestone lists are
+// only available in GitLab EE.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html#new-board-list
 func (s *IssueBoardsService) CreateIssueBoardList(pid interface{}, board int, opt *CreateIssueBoardListOptions, options ...RequestOptionFunc) (*BoardList, *Response, error) {
@@ -288,7 +300,7 @@ func (s *IssueBoardsService) CreateIssueBoardList(pid interface{}, board int, op
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html#edit-board-list
 type UpdateIssueBoardListOptions struct {
-	Position *int `url:"position" json:"position"`
+	Position *int `url:"position,omitempty" json:"position,omitempty"`
 }
 
 // UpdateIssueBoardList updates the position of an existing issue board list.
@@ -0,0 +1,152 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListIssueBoards(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"Team board","milestone":{"id":12,"title":"10.0"},"lists":[{"id":1,"label":{"id":3,"name":"Testing"},"position":1}]}]`)
+	})
+
+	boards, _, err := client.Boards.ListIssueBoards(5, nil)
+	if err != nil {
+		t.Fatalf("Boards.ListIssueBoards returned error: %v", err)
+	}
+
+	want := []*IssueBoard{{
+		ID:        1,
+		Name:      "Team board",
+		Milestone: &Milestone{ID: 12, Title: "10.0"},
+		Lists: []*BoardList{{
+			ID:       1,
+			Label:    &Label{ID: 3, Name: "Testing"},
+			Position: 1,
+		}},
+	}}
+	if !reflect.DeepEqual(want, boards) {
+		t.Errorf("Boards.ListIssueBoards returned %+v, want %+v", boards, want)
+	}
+}
+
+func TestCreateIssueBoard(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Kanban"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":2,"name":"Kanban","lists":[]}`)
+	})
+
+	board, _, err := client.Boards.CreateIssueBoard(5, &CreateIssueBoardOptions{Name: String("Kanban")})
+	if err != nil {
+		t.Fatalf("Boards.CreateIssueBoard returned error: %v", err)
+	}
+
+	want := &IssueBoard{ID: 2, Name: "Kanban", Lists: []*BoardList{}}
+	if !reflect.DeepEqual(want, board) {
+		t.Errorf("Boards.CreateIssueBoard returned %+v, want %+v", board, want)
+	}
+}
+
+func TestUpdateIssueBoard(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"Scoped board","assignee_id":4,"milestone_id":12,"labels":"Doing,Review","weight":3}`)
+		fmt.Fprint(w, `{"id":2,"name":"Scoped board","assignee":{"id":4,"username":"jdoe"},"milestone":{"id":12},"labels":[{"id":1,"name":"Doing"},{"id":2,"name":"Review"}],"weight":3}`)
+	})
+
+	opt := &UpdateIssueBoardOptions{
+		Name:        String("Scoped board"),
+		AssigneeID:  Int(4),
+		MilestoneID: Int(12),
+		Labels:      Labels{"Doing", "Review"},
+		Weight:      Int(3),
+	}
+	board, _, err := client.Boards.UpdateIssueBoard(5, 2, opt)
+	if err != nil {
+		t.Fatalf("Boards.UpdateIssueBoard returned error: %v", err)
+	}
+
+	want := &IssueBoard{
+		ID:        2,
+		Name:      "Scoped board",
+		Assignee:  &BasicUser{ID: 4, Username: "jdoe"},
+		Milestone: &Milestone{ID: 12},
+		Labels:    []*Label{{ID: 1, Name: "Doing"}, {ID: 2, Name: "Review"}},
+		Weight:    3,
+	}
+	if !reflect.DeepEqual(want, board) {
+		t.Errorf("Boards.UpdateIssueBoard returned %+v, want %+v", board, want)
+	}
+}
+
+func TestCreateIssueBoardListForAssignee(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards/2/lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"assignee_id":4}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":9,"label":null,"assignee":{"id":4,"username":"jdoe"},"position":0}`)
+	})
+
+	list, _, err := client.Boards.CreateIssueBoardList(5, 2, &CreateIssueBoardListOptions{AssigneeID: Int(4)})
+	if err != nil {
+		t.Fatalf("Boards.CreateIssueBoardList returned error: %v", err)
+	}
+
+	want := &BoardList{ID: 9, Assignee: &BasicUser{ID: 4, Username: "jdoe"}}
+	if !reflect.DeepEqual(want, list) {
+		t.Errorf("Boards.CreateIssueBoardList returned %+v, want %+v", list, want)
+	}
+}
+
+func TestUpdateIssueBoardList(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards/2/lists/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"position":0}`)
+		fmt.Fprint(w, `{"id":9,"label":{"id":3,"name":"Testing"},"position":0}`)
+	})
+
+	list, _, err := client.Boards.UpdateIssueBoardList(5, 2, 9, &UpdateIssueBoardListOptions{Position: Int(0)})
+	if err != nil {
+		t.Fatalf("Boards.UpdateIssueBoardList returned error: %v", err)
+	}
+
+	want := &BoardList{ID: 9, Label: &Label{ID: 3, Name: "Testing"}}
+	if !reflect.DeepEqual(want, list) {
+		t.Errorf("Boards.UpdateIssueBoardList returned %+v, want %+v", list, want)
+	}
+}
+
+func TestDeleteIssueBoardList(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/boards/2/lists/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Boards.DeleteIssueBoardList(5, 2, 9)
+	if err != nil {
+		t.Fatalf("Boards.DeleteIssueBoardList returned error: %v", err)
+	}
+}
@@ -55,6 +55,7 @@ func (b Branch) String() string {
 type ListBranchesOptions struct {
 	ListOptions
 	Search *string `url:"search,omitempty" json:"search,omitempty"`
+	Regex  *string `url:"regex,omitempty" json:"regex,omitempty"`
 }
 
 // ListBranches gets a list of repository branches from a project, sorted by
@@ -121,6 +122,8 @@ type ProtectBranchOptions struct {
 // idempotent function, protecting an already protected repository branch
 // still returns a 200 OK status code.
 //
+// Deprecated: use ProtectedBranchesService.ProtectRepositoryBranches instead.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/branches.html#protect-repository-branch
 func (s *BranchesService) ProtectBranch(pid interface{}, branch string, opts *ProtectBranchOptions, options ...RequestOptionFunc) (*Branch, *Response, error) {
@@ -148,6 +151,8 @@ func (s *BranchesService) ProtectBranch(pid interface{}, branch string, opts *Pr
 // idempotent function, unprotecting an already unprotected repository branch
 // still returns a 200 OK status code.
 //
+// Deprecated: use ProtectedBranchesService.UnprotectRepositoryBranches instead.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/branches.html#unprotect-repository-branch
 func (s *BranchesService) UnprotectBranch(pid interface{}, branch string, options ...RequestOptionFunc) (*Branch, *Response, error) {
@@ -225,6 +230,8 @@ func (s *BranchesService) DeleteBranch(pid interface{}, branch string, options .
 }
 
 // DeleteMergedBranches deletes all branches that are merged into the project's default branch.
+// Protected branches are not deleted. The deletion is done asynchronously, so
+// GitLab responds with 202 Accepted before all branches are actually removed.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/branches.html#delete-merged-branches
@@ -1,7 +1,9 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
@@ -49,3 +51,95 @@ func TestGetBranch(t *testing.T) {
 
 	assert.Equal(t, want, branch)
 }
+
+func TestListBranches(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/branches?page=2&per_page=50&regex=%5Efeature%2F.%2A")
+		fmt.Fprint(w, `[{"name":"feature/foo","merged":true,"protected":false,"default":false,"can_push":true,"web_url":"https://gitlab.example.com/my-group/my-project/-/tree/feature/foo"}]`)
+	})
+
+	opt := &ListBranchesOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 50},
+		Regex:       String("^feature/.*"),
+	}
+	branches, _, err := client.Branches.ListBranches(1, opt)
+	if err != nil {
+		t.Fatalf("Branches.ListBranches returned error: %v", err)
+	}
+
+	want := []*Branch{{
+		Name:    "feature/foo",
+		Merged:  true,
+		CanPush: true,
+		WebURL:  "https://gitlab.example.com/my-group/my-project/-/tree/feature/foo",
+	}}
+	if !reflect.DeepEqual(want, branches) {
+		t.Errorf("Branches.ListBranches returned %+v, want %+v", branches, want)
+	}
+}
+
+func TestCreateBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"newbranch","ref":"master"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"newbranch","merged":false,"protected":false,"developers_can_push":false,"developers_can_merge":false}`)
+	})
+
+	opt := &CreateBranchOptions{
+		Branch: String("newbranch"),
+		Ref:    String("master"),
+	}
+	branch, _, err := client.Branches.CreateBranch(1, opt)
+	if err != nil {
+		t.Fatalf("Branches.CreateBranch returned error: %v", err)
+	}
+
+	want := &Branch{Name: "newbranch"}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("Branches.CreateBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestDeleteBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches/feature/foo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/repository/branches/feature%2Ffoo")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Branches.DeleteBranch(1, "feature/foo")
+	if err != nil {
+		t.Fatalf("Branches.DeleteBranch returned error: %v", err)
+	}
+}
+
+func TestDeleteMergedBranches(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/merged_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"202 Accepted"}`)
+	})
+
+	resp, err := client.Branches.DeleteMergedBranches(1)
+	if err != nil {
+		t.Fatalf("Branches.DeleteMergedBranches returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Branches.DeleteMergedBranches returned status %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+}
@@ -18,6 +18,7 @@ type CIYMLTemplatesService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/templates/gitlab_ci_ymls.html
 type CIYMLTemplate struct {
+	Key     string `json:"key"`
 	Name    string `json:"name"`
 	Content string `json:"content"`
 }
@@ -25,7 +26,7 @@ type CIYMLTemplate struct {
 // ListCIYMLTemplatesOptions represents the available ListAllTemplates() options.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/templates/gitignores.html#list-gitignore-templates
+// https://docs.gitlab.com/ce/api/templates/gitlab_ci_ymls.html#list-gitlab-ci-yml-templates
 type ListCIYMLTemplatesOptions ListOptions
 
 // ListAllTemplates get all GitLab CI YML templates.
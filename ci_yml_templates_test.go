@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListAllCIYMLTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/gitlab_ci_ymls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key":"Android","name":"Android"},{"key":"Go","name":"Go"}]`)
+	})
+
+	templates, _, err := client.CIYMLTemplate.ListAllTemplates(nil)
+	if err != nil {
+		t.Fatalf("CIYMLTemplate.ListAllTemplates returned error: %v", err)
+	}
+
+	want := []*CIYMLTemplate{{Key: "Android", Name: "Android"}, {Key: "Go", Name: "Go"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("CIYMLTemplate.ListAllTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetCIYMLTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/gitlab_ci_ymls/Go", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"Go","content":"image: golang:latest\n"}`)
+	})
+
+	template, _, err := client.CIYMLTemplate.GetTemplate("Go")
+	if err != nil {
+		t.Fatalf("CIYMLTemplate.GetTemplate returned error: %v", err)
+	}
+
+	want := &CIYMLTemplate{Name: "Go", Content: "image: golang:latest\n"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("CIYMLTemplate.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
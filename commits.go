@@ -34,26 +34,27 @@ type CommitsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html
 type Commit struct {
-	ID             string           `json:"id"`
-	ShortID        string           `json:"short_id"`
-	Title          string           `json:"title"`
-	AuthorName     string           `json:"author_name"`
-	AuthorEmail    string           `json:"author_email"`
-	AuthoredDate   *time.Time       `json:"authored_date"`
-	CommitterName  string           `json:"committer_name"`
-	CommitterEmail string           `json:"committer_email"`
-	CommittedDate  *time.Time       `json:"committed_date"`
-	CreatedAt      *time.Time       `json:"created_at"`
-	Message        string           `json:"message"`
-	ParentIDs      []string         `json:"parent_ids"`
-	Stats          *CommitStats     `json:"stats"`
-	Status         *BuildStateValue `json:"status"`
-	LastPipeline   *PipelineInfo    `json:"last_pipeline"`
-	ProjectID      int              `json:"project_id"`
-	WebURL         string           `json:"web_url"`
-}
-
-// CommitStats represents the number of added and deleted files in a commit.
+	ID             string            `json:"id"`
+	ShortID        string            `json:"short_id"`
+	Title          string            `json:"title"`
+	AuthorName     string            `json:"author_name"`
+	AuthorEmail    string            `json:"author_email"`
+	AuthoredDate   *time.Time        `json:"authored_date"`
+	CommitterName  string            `json:"committer_name"`
+	CommitterEmail string            `json:"committer_email"`
+	CommittedDate  *time.Time        `json:"committed_date"`
+	CreatedAt      *time.Time        `json:"created_at"`
+	Message        string            `json:"message"`
+	ParentIDs      []string          `json:"parent_ids"`
+	Trailers       map[string]string `json:"trailers"`
+	Stats          *CommitStats      `json:"stats"`
+	Status         *BuildStateValue  `json:"status"`
+	LastPipeline   *PipelineInfo     `json:"last_pipeline"`
+	ProjectID      int               `json:"project_id"`
+	WebURL         string            `json:"web_url"`
+}
+
+// CommitStats represents the number of added and deleted lines in a commit.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html
 type CommitStats struct {
@@ -78,9 +79,12 @@ type ListCommitsOptions struct {
 	All         *bool      `url:"all,omitempty" json:"all,omitempty"`
 	WithStats   *bool      `url:"with_stats,omitempty" json:"with_stats,omitempty"`
 	FirstParent *bool      `url:"first_parent,omitempty" json:"first_parent,omitempty"`
+	Trailers    *bool      `url:"trailers,omitempty" json:"trailers,omitempty"`
 }
 
-// ListCommits gets a list of repository commits in a project.
+// ListCommits gets a list of repository commits in a project. Set WithStats
+// to include the stats of each commit and Trailers to include the Git
+// trailers parsed from the commit message.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#list-commits
 func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, options ...RequestOptionFunc) ([]*Commit, *Response, error) {
@@ -104,28 +108,40 @@ func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, o
 	return c, resp, err
 }
 
-// FileAction represents the available actions that can be performed on a file.
+// FileActionValue represents the available actions that can be performed on a file.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
-type FileAction string
+type FileActionValue string
 
 // The available file actions.
 const (
-	FileCreate FileAction = "create"
-	FileDelete FileAction = "delete"
-	FileMove   FileAction = "move"
-	FileUpdate FileAction = "update"
+	FileCreate FileActionValue = "create"
+	FileDelete FileActionValue = "delete"
+	FileMove   FileActionValue = "move"
+	FileUpdate FileActionValue = "update"
+	FileChmod  FileActionValue = "chmod"
 )
 
-// CommitAction represents a single file action within a commit.
-type CommitAction struct {
-	Action          FileAction `url:"action" json:"action"`
-	FilePath        string     `url:"file_path" json:"file_path"`
-	PreviousPath    string     `url:"previous_path,omitempty" json:"previous_path,omitempty"`
-	Content         string     `url:"content,omitempty" json:"content,omitempty"`
-	Encoding        string     `url:"encoding,omitempty" json:"encoding,omitempty"`
-	LastCommitID    string     `url:"last_commit_id,omitempty" json:"last_commit_id,omitempty"`
-	ExecuteFilemode bool       `url:"execute_filemode,omitempty" json:"execute_filemode,omitempty"`
+// CommitActionOptions represents the available options for a single file
+// action within a new commit.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
+type CommitActionOptions struct {
+	Action          *FileActionValue `url:"action,omitempty" json:"action,omitempty"`
+	FilePath        *string          `url:"file_path,omitempty" json:"file_path,omitempty"`
+	PreviousPath    *string          `url:"previous_path,omitempty" json:"previous_path,omitempty"`
+	Content         *string          `url:"content,omitempty" json:"content,omitempty"`
+	Encoding        *string          `url:"encoding,omitempty" json:"encoding,omitempty"`
+	LastCommitID    *string          `url:"last_commit_id,omitempty" json:"last_commit_id,omitempty"`
+	ExecuteFilemode *bool            `url:"execute_filemode,omitempty" json:"execute_filemode,omitempty"`
+}
+
+// FileAction is a helper routine that allocates a new FileActionValue to
+// store v and returns a pointer to it.
+func FileAction(v FileActionValue) *FileActionValue {
+	p := new(FileActionValue)
+	*p = v
+	return p
 }
 
 // CommitRef represents the reference of branches/tags in a commit.
@@ -143,10 +159,11 @@ type CommitRef struct {
 // https://docs.gitlab.com/ce/api/commits.html#get-references-a-commit-is-pushed-to
 type GetCommitRefsOptions struct {
 	ListOptions
-	Type *string `url:"type,omitempty" json:"type,omitempty"`
+	Type *CommitRefTypeValue `url:"type,omitempty" json:"type,omitempty"`
 }
 
-// GetCommitRefs gets all references (from branches or tags) a commit is pushed to
+// GetCommitRefs gets all references (from branches or tags) a commit is pushed
+// to. This tells whether a commit has reached a given branch or tag.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/commits.html#get-references-a-commit-is-pushed-to
@@ -203,19 +220,20 @@ func (s *CommitsService) GetCommit(pid interface{}, sha string, options ...Reque
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
 type CreateCommitOptions struct {
-	Branch        *string         `url:"branch" json:"branch"`
-	CommitMessage *string         `url:"commit_message" json:"commit_message"`
-	StartBranch   *string         `url:"start_branch,omitempty" json:"start_branch,omitempty"`
-	StartSHA      *string         `url:"start_sha,omitempty" json:"start_sha,omitempty"`
-	StartProject  *string         `url:"start_project,omitempty" json:"start_project,omitempty"`
-	Actions       []*CommitAction `url:"actions" json:"actions"`
-	AuthorEmail   *string         `url:"author_email,omitempty" json:"author_email,omitempty"`
-	AuthorName    *string         `url:"author_name,omitempty" json:"author_name,omitempty"`
-	Stats         *bool           `url:"stats,omitempty" json:"stats,omitempty"`
-	Force         *bool           `url:"force,omitempty" json:"force,omitempty"`
+	Branch        *string                `url:"branch" json:"branch"`
+	CommitMessage *string                `url:"commit_message" json:"commit_message"`
+	StartBranch   *string                `url:"start_branch,omitempty" json:"start_branch,omitempty"`
+	StartSHA      *string                `url:"start_sha,omitempty" json:"start_sha,omitempty"`
+	StartProject  *string                `url:"start_project,omitempty" json:"start_project,omitempty"`
+	Actions       []*CommitActionOptions `url:"actions" json:"actions"`
+	AuthorEmail   *string                `url:"author_email,omitempty" json:"author_email,omitempty"`
+	AuthorName    *string                `url:"author_name,omitempty" json:"author_name,omitempty"`
+	Stats         *bool                  `url:"stats,omitempty" json:"stats,omitempty"`
+	Force         *bool                  `url:"force,omitempty" json:"force,omitempty"`
 }
 
-// CreateCommit creates a commit with multiple files and actions.
+// CreateCommit creates a commit with multiple files and actions. All actions
+// are applied atomically in a single commit.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
 func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
@@ -231,7 +249,7 @@ func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions,
 	}
 
 	c := new(Commit)
-	resp, err := s.client.Do(req, &c)
+	resp, err := s.client.Do(req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -263,7 +281,7 @@ func (d Diff) String() string {
 // https://docs.gitlab.com/ce/api/commits.html#get-the-diff-of-a-commit
 type GetCommitDiffOptions ListOptions
 
-// GetCommitDiff gets the diff of a commit in a project..
+// GetCommitDiff gets the diff of a commit in a project.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/commits.html#get-the-diff-of-a-commit
@@ -352,14 +370,14 @@ func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *Get
 // https://docs.gitlab.com/ce/api/commits.html#post-comment-to-commit
 type PostCommitCommentOptions struct {
 	Note     *string `url:"note,omitempty" json:"note,omitempty"`
-	Path     *string `url:"path" json:"path"`
-	Line     *int    `url:"line" json:"line"`
-	LineType *string `url:"line_type" json:"line_type"`
+	Path     *string `url:"path,omitempty" json:"path,omitempty"`
+	Line     *int    `url:"line,omitempty" json:"line,omitempty"`
+	LineType *string `url:"line_type,omitempty" json:"line_type,omitempty"`
 }
 
 // PostCommitComment adds a comment to a commit. Optionally you can post
-// comments on a specific line of a commit. Therefor both path, line_new and
-// line_old are required.
+// comments on a specific line of a commit. Therefor path, line and line_type
+// are required, where line_type is either "new" or "old".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/commits.html#post-comment-to-commit
@@ -399,17 +417,20 @@ type GetCommitStatusesOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#get-the-status-of-a-commit
 type CommitStatus struct {
-	ID          int        `json:"id"`
-	SHA         string     `json:"sha"`
-	Ref         string     `json:"ref"`
-	Status      string     `json:"status"`
-	Name        string     `json:"name"`
-	TargetURL   string     `json:"target_url"`
-	Description string     `json:"description"`
-	CreatedAt   *time.Time `json:"created_at"`
-	StartedAt   *time.Time `json:"started_at"`
-	FinishedAt  *time.Time `json:"finished_at"`
-	Author      Author     `json:"author"`
+	ID           int        `json:"id"`
+	SHA          string     `json:"sha"`
+	Ref          string     `json:"ref"`
+	Status       string     `json:"status"`
+	Name         string     `json:"name"`
+	TargetURL    string     `json:"target_url"`
+	Description  string     `json:"description"`
+	Coverage     float64    `json:"coverage"`
+	AllowFailure bool       `json:"allow_failure"`
+	PipelineID   int        `json:"pipeline_id"`
+	CreatedAt    *time.Time `json:"created_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+	Author       Author     `json:"author"`
 }
 
 // GetCommitStatuses gets the statuses of a commit in a project.
@@ -466,7 +487,7 @@ func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCo
 	}
 
 	cs := new(CommitStatus)
-	resp, err := s.client.Do(req, &cs)
+	resp, err := s.client.Do(req, cs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -503,10 +524,14 @@ func (s *CommitsService) GetMergeRequestsByCommit(pid interface{}, sha string, o
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#cherry-pick-a-commit
 type CherryPickCommitOptions struct {
-	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	Branch  *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun  *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
+	Message *string `url:"message,omitempty" json:"message,omitempty"`
 }
 
-// CherryPickCommit cherry picks a commit to a given branch.
+// CherryPickCommit cherry picks a commit to a given branch. When the commit
+// cannot be cherry-picked, the returned *ErrorResponse has its ErrorCode set,
+// e.g. to "conflict" or "empty".
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#cherry-pick-a-commit
 func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *CherryPickCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
@@ -535,9 +560,12 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 type RevertCommitOptions struct {
 	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// RevertCommit reverts a commit in a given branch.
+// RevertCommit reverts a commit in a given branch. When the commit cannot be
+// reverted, the returned *ErrorResponse has its ErrorCode set, e.g. to
+// "conflict" or "empty".
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
@@ -566,18 +594,50 @@ func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCo
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
 type GPGSignature struct {
-	KeyID              int    `json:"gpg_key_id"`
-	KeyPrimaryKeyID    string `json:"gpg_key_primary_keyid"`
-	KeyUserName        string `json:"gpg_key_user_name"`
-	KeyUserEmail       string `json:"gpg_key_user_email"`
-	VerificationStatus string `json:"verification_status"`
-	KeySubkeyID        int    `json:"gpg_key_subkey_id"`
+	SignatureType      string           `json:"signature_type"`
+	KeyID              int              `json:"gpg_key_id"`
+	KeyPrimaryKeyID    string           `json:"gpg_key_primary_keyid"`
+	KeyUserName        string           `json:"gpg_key_user_name"`
+	KeyUserEmail       string           `json:"gpg_key_user_email"`
+	VerificationStatus string           `json:"verification_status"`
+	KeySubkeyID        int              `json:"gpg_key_subkey_id"`
+	X509Certificate    *X509Certificate `json:"x509_certificate"`
+	CommitSource       string           `json:"commit_source"`
 }
 
-// GetGPGSiganature gets a GPG signature of a commit.
+// X509Certificate represents the certificate a commit was signed with when
+// the signature type is X509.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
+type X509Certificate struct {
+	ID                   int         `json:"id"`
+	Subject              string      `json:"subject"`
+	SubjectKeyIdentifier string      `json:"subject_key_identifier"`
+	Email                string      `json:"email"`
+	SerialNumber         string      `json:"serial_number"`
+	CertificateStatus    string      `json:"certificate_status"`
+	X509Issuer           *X509Issuer `json:"x509_issuer"`
+}
+
+// X509Issuer represents the issuer of an X509 certificate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
+type X509Issuer struct {
+	ID                   int    `json:"id"`
+	Subject              string `json:"subject"`
+	SubjectKeyIdentifier string `json:"subject_key_identifier"`
+	CrlURL               string `json:"crl_url"`
+}
+
+// GetGPGSignature gets the signature of a commit. The signature can be of
+// type PGP, X509 or SSH. If the commit is not signed, GitLab responds with
+// a 404 which can be told apart from other errors by checking the status
+// code of the returned response.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
-func (s *CommitsService) GetGPGSiganature(pid interface{}, sha string, options ...RequestOptionFunc) (*GPGSignature, *Response, error) {
+func (s *CommitsService) GetGPGSignature(pid interface{}, sha string, options ...RequestOptionFunc) (*GPGSignature, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
@@ -590,10 +650,17 @@ func (s *CommitsService) GetGPGSiganature(pid interface{}, sha string, options .
 	}
 
 	sig := new(GPGSignature)
-	resp, err := s.client.Do(req, &sig)
+	resp, err := s.client.Do(req, sig)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return sig, resp, err
 }
+
+// GetGPGSiganature gets a GPG signature of a commit.
+//
+// Deprecated: use GetGPGSignature instead.
+func (s *CommitsService) GetGPGSiganature(pid interface{}, sha string, options ...RequestOptionFunc) (*GPGSignature, *Response, error) {
+	return s.GetGPGSignature(pid, sha, options...)
+}
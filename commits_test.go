@@ -16,6 +16,53 @@ import (
 
 var testRevertCommitTargetBranch = "release"
 
+func TestListCommits(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits?all=true&first_parent=true&path=app%2Fmodels&ref_name=master&since=2021-01-01T00%3A00%3A00Z&trailers=true&until=2021-02-01T00%3A00%3A00Z&with_stats=true")
+		fmt.Fprint(w, `[{
+			"id": "ed899a2f4b50b4370feeea94676502b42383c746",
+			"short_id": "ed899a2f4b5",
+			"title": "Replace sanitize with escape once",
+			"parent_ids": ["6104942438c14ec7bd21c6cd5bd995272b3faff6"],
+			"trailers": {"Changelog": "fixed"},
+			"stats": {"additions": 15, "deletions": 10, "total": 25},
+			"web_url": "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746"
+		}]`)
+	})
+
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListCommitsOptions{
+		RefName:     String("master"),
+		Since:       &since,
+		Until:       &until,
+		Path:        String("app/models"),
+		All:         Bool(true),
+		WithStats:   Bool(true),
+		FirstParent: Bool(true),
+		Trailers:    Bool(true),
+	}
+	commits, _, err := client.Commits.ListCommits(1, opt)
+	if err != nil {
+		t.Fatalf("Commits.ListCommits returned error: %v", err)
+	}
+
+	want := []*Commit{{
+		ID:        "ed899a2f4b50b4370feeea94676502b42383c746",
+		ShortID:   "ed899a2f4b5",
+		Title:     "Replace sanitize with escape once",
+		ParentIDs: []string{"6104942438c14ec7bd21c6cd5bd995272b3faff6"},
+		Trailers:  map[string]string{"Changelog": "fixed"},
+		Stats:     &CommitStats{Additions: 15, Deletions: 10, Total: 25},
+		WebURL:    "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
+	}}
+	assert.Equal(t, want, commits)
+}
+
 func TestGetCommit(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -65,7 +112,8 @@ func TestGetCommitStatuses(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/statuses", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `[{"id":1}]`)
+		testURL(t, r, "/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/statuses?all=true&name=ci%2Fjenkins&ref=master&stage=test")
+		fmt.Fprint(w, `[{"id":1,"status":"success","coverage":98.5,"allow_failure":true,"pipeline_id":7}]`)
 	})
 
 	opt := &GetCommitStatusesOptions{
@@ -80,7 +128,7 @@ func TestGetCommitStatuses(t *testing.T) {
 		t.Errorf("Commits.GetCommitStatuses returned error: %v", err)
 	}
 
-	want := []*CommitStatus{{ID: 1}}
+	want := []*CommitStatus{{ID: 1, Status: "success", Coverage: 98.5, AllowFailure: true, PipelineID: 7}}
 	if !reflect.DeepEqual(want, statuses) {
 		t.Errorf("Commits.GetCommitStatuses returned %+v, want %+v", statuses, want)
 	}
@@ -126,6 +174,30 @@ func TestSetCommitStatus(t *testing.T) {
 	}
 }
 
+func TestSetCommitStatusInvalidTransition(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/statuses/b0b3a907f41409829b307a28b82fdbd552ee5a27", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Cannot transition status via :run from :running (Reason(s): Status cannot transition via \"run\")"}`)
+	})
+
+	opt := &SetCommitStatusOptions{State: Running}
+	_, _, err := client.Commits.SetCommitStatus("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Commits.SetCommitStatus returned error %v, want *ErrorResponse", err)
+	}
+
+	want := `{message: Cannot transition status via :run from :running (Reason(s): Status cannot transition via "run")}`
+	if errResp.Message != want {
+		t.Errorf("Commits.SetCommitStatus returned message %q, want %q", errResp.Message, want)
+	}
+}
+
 func TestRevertCommit_NoOptions(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -224,7 +296,7 @@ func TestGetGPGSignature(t *testing.T) {
 		mustWriteHTTPResponse(t, w, "testdata/get_signature.json")
 	})
 
-	sig, resp, err := client.Commits.GetGPGSiganature("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", nil)
+	sig, resp, err := client.Commits.GetGPGSignature("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", nil)
 	if err != nil {
 		t.Fatalf("Commits.GetGPGSignature returned error: %v, response: %v", err, resp)
 	}
@@ -240,3 +312,313 @@ func TestGetGPGSignature(t *testing.T) {
 
 	assert.Equal(t, want, sig)
 }
+
+func TestGetX509Signature(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/daf4fc3d6e1e37c0cbd8c7bc9fe2d513e1a2131b/signature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"signature_type": "X509",
+			"verification_status": "unverified",
+			"x509_certificate": {
+				"id": 1,
+				"subject": "CN=gitlab@example.org,OU=Example,O=World",
+				"subject_key_identifier": "BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC",
+				"email": "gitlab@example.org",
+				"serial_number": "278969561018901340486471282831158785578",
+				"certificate_status": "good",
+				"x509_issuer": {
+					"id": 1,
+					"subject": "CN=PKI,OU=Example,O=World",
+					"subject_key_identifier": "AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB",
+					"crl_url": "http://example.com/pki.crl"
+				}
+			},
+			"commit_source": "gitaly"
+		}`)
+	})
+
+	sig, _, err := client.Commits.GetGPGSignature(1, "daf4fc3d6e1e37c0cbd8c7bc9fe2d513e1a2131b")
+	if err != nil {
+		t.Fatalf("Commits.GetGPGSignature returned error: %v", err)
+	}
+
+	want := &GPGSignature{
+		SignatureType:      "X509",
+		VerificationStatus: "unverified",
+		X509Certificate: &X509Certificate{
+			ID:                   1,
+			Subject:              "CN=gitlab@example.org,OU=Example,O=World",
+			SubjectKeyIdentifier: "BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC:BC",
+			Email:                "gitlab@example.org",
+			SerialNumber:         "278969561018901340486471282831158785578",
+			CertificateStatus:    "good",
+			X509Issuer: &X509Issuer{
+				ID:                   1,
+				Subject:              "CN=PKI,OU=Example,O=World",
+				SubjectKeyIdentifier: "AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB:AB",
+				CrlURL:               "http://example.com/pki.crl",
+			},
+		},
+		CommitSource: "gitaly",
+	}
+
+	assert.Equal(t, want, sig)
+}
+
+func TestGetGPGSignatureUnsignedCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/signature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Signature Not Found"}`)
+	})
+
+	sig, resp, err := client.Commits.GetGPGSignature(1, "b0b3a907f41409829b307a28b82fdbd552ee5a27")
+	if err == nil {
+		t.Fatal("Commits.GetGPGSignature expected an error for an unsigned commit")
+	}
+	if sig != nil {
+		t.Errorf("Commits.GetGPGSignature returned %+v, want nil", sig)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Commits.GetGPGSignature returned response %+v, want status %d", resp, http.StatusNotFound)
+	}
+}
+
+func TestCreateCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"master","commit_message":"Sync templates","start_sha":"ed899a2f4b50b4370feeea94676502b42383c746","actions":[`+
+			`{"action":"create","file_path":"docs/README.md","content":"# Docs"},`+
+			`{"action":"move","file_path":"ci/build.yml","previous_path":"build.yml"},`+
+			`{"action":"chmod","file_path":"scripts/deploy.sh","execute_filemode":true},`+
+			`{"action":"delete","file_path":"old.txt"}],"author_email":"bot@example.com","author_name":"Template Bot"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "ed899a2f4b50b4370feeea94676502b42383c746", "short_id": "ed899a2f4b5", "title": "Sync templates"}`)
+	})
+
+	opt := &CreateCommitOptions{
+		Branch:        String("master"),
+		CommitMessage: String("Sync templates"),
+		StartSHA:      String("ed899a2f4b50b4370feeea94676502b42383c746"),
+		Actions: []*CommitActionOptions{
+			{
+				Action:   FileAction(FileCreate),
+				FilePath: String("docs/README.md"),
+				Content:  String("# Docs"),
+			},
+			{
+				Action:       FileAction(FileMove),
+				FilePath:     String("ci/build.yml"),
+				PreviousPath: String("build.yml"),
+			},
+			{
+				Action:          FileAction(FileChmod),
+				FilePath:        String("scripts/deploy.sh"),
+				ExecuteFilemode: Bool(true),
+			},
+			{
+				Action:   FileAction(FileDelete),
+				FilePath: String("old.txt"),
+			},
+		},
+		AuthorEmail: String("bot@example.com"),
+		AuthorName:  String("Template Bot"),
+	}
+	commit, _, err := client.Commits.CreateCommit(1, opt)
+	if err != nil {
+		t.Fatalf("Commits.CreateCommit returned error: %v", err)
+	}
+
+	want := &Commit{ID: "ed899a2f4b50b4370feeea94676502b42383c746", ShortID: "ed899a2f4b5", Title: "Sync templates"}
+	if !reflect.DeepEqual(want, commit) {
+		t.Errorf("Commits.CreateCommit returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestCherryPickCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"release","message":"Backport fix"}`)
+		fmt.Fprint(w, `{"id": "8b090c1b79a14f2bd9e8a738f717824ff53aebad", "short_id": "8b090c1b", "title": "Backport fix"}`)
+	})
+
+	opt := &CherryPickCommitOptions{
+		Branch:  String("release"),
+		Message: String("Backport fix"),
+	}
+	commit, _, err := client.Commits.CherryPickCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+	if err != nil {
+		t.Fatalf("Commits.CherryPickCommit returned error: %v", err)
+	}
+
+	want := &Commit{ID: "8b090c1b79a14f2bd9e8a738f717824ff53aebad", ShortID: "8b090c1b", Title: "Backport fix"}
+	if !reflect.DeepEqual(want, commit) {
+		t.Errorf("Commits.CherryPickCommit returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestCherryPickCommitConflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"release","dry_run":true}`)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Sorry, we cannot cherry-pick this commit automatically.", "error_code": "conflict"}`)
+	})
+
+	opt := &CherryPickCommitOptions{
+		Branch: String("release"),
+		DryRun: Bool(true),
+	}
+	_, _, err := client.Commits.CherryPickCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Commits.CherryPickCommit returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.ErrorCode != "conflict" {
+		t.Errorf("Commits.CherryPickCommit returned error code %q, want %q", errResp.ErrorCode, "conflict")
+	}
+}
+
+func TestRevertCommitConflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/revert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"release","dry_run":true}`)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Sorry, we cannot revert this commit automatically.", "error_code": "conflict"}`)
+	})
+
+	opt := &RevertCommitOptions{
+		Branch: String("release"),
+		DryRun: Bool(true),
+	}
+	_, _, err := client.Commits.RevertCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Commits.RevertCommit returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.ErrorCode != "conflict" {
+		t.Errorf("Commits.RevertCommit returned error code %q, want %q", errResp.ErrorCode, "conflict")
+	}
+}
+
+func TestGetCommitRefs(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/5937ac0a7beb003549fc5fd26fc247adbce4a52e/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits/5937ac0a7beb003549fc5fd26fc247adbce4a52e/refs?page=1&per_page=100&type=branch")
+		fmt.Fprint(w, `[{"type": "branch", "name": "master"}, {"type": "branch", "name": "release-1.0"}]`)
+	})
+
+	opt := &GetCommitRefsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 100},
+		Type:        CommitRefType(BranchCommitRefType),
+	}
+	refs, _, err := client.Commits.GetCommitRefs(1, "5937ac0a7beb003549fc5fd26fc247adbce4a52e", opt)
+	if err != nil {
+		t.Fatalf("Commits.GetCommitRefs returned error: %v", err)
+	}
+
+	want := []*CommitRef{{Type: "branch", Name: "master"}, {Type: "branch", Name: "release-1.0"}}
+	if !reflect.DeepEqual(want, refs) {
+		t.Errorf("Commits.GetCommitRefs returned %+v, want %+v", refs, want)
+	}
+}
+
+func TestGetCommitDiff(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/diff", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits/master/diff?page=1&per_page=20")
+		fmt.Fprint(w, `[{"diff": "--- a/doc/update/5.4-to-6.0.md\n+++ b/doc/update/5.4-to-6.0.md\n", "new_path": "doc/update/5.4-to-6.0.md", "old_path": "doc/update/5.4-to-6.0.md", "a_mode": null, "b_mode": "100644", "new_file": false, "renamed_file": false, "deleted_file": false}]`)
+	})
+
+	diffs, _, err := client.Commits.GetCommitDiff(1, "master", &GetCommitDiffOptions{Page: 1, PerPage: 20})
+	if err != nil {
+		t.Fatalf("Commits.GetCommitDiff returned error: %v", err)
+	}
+
+	want := []*Diff{{
+		Diff:    "--- a/doc/update/5.4-to-6.0.md\n+++ b/doc/update/5.4-to-6.0.md\n",
+		NewPath: "doc/update/5.4-to-6.0.md",
+		OldPath: "doc/update/5.4-to-6.0.md",
+		BMode:   "100644",
+	}}
+	if !reflect.DeepEqual(want, diffs) {
+		t.Errorf("Commits.GetCommitDiff returned %+v, want %+v", diffs, want)
+	}
+}
+
+func TestPostCommitComment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/18f3e63d05582537db6d183d9d557be09e1f90c8/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"note":"Possible SQL injection","path":"app/models/user.rb","line":11,"line_type":"new"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"note": "Possible SQL injection", "path": "app/models/user.rb", "line": 11, "line_type": "new", "author": {"id": 1, "username": "bot"}}`)
+	})
+
+	opt := &PostCommitCommentOptions{
+		Note:     String("Possible SQL injection"),
+		Path:     String("app/models/user.rb"),
+		Line:     Int(11),
+		LineType: String("new"),
+	}
+	comment, _, err := client.Commits.PostCommitComment(1, "18f3e63d05582537db6d183d9d557be09e1f90c8", opt)
+	if err != nil {
+		t.Fatalf("Commits.PostCommitComment returned error: %v", err)
+	}
+
+	want := &CommitComment{
+		Note:     "Possible SQL injection",
+		Path:     "app/models/user.rb",
+		Line:     11,
+		LineType: "new",
+		Author:   Author{ID: 1, Username: "bot"},
+	}
+	if !reflect.DeepEqual(want, comment) {
+		t.Errorf("Commits.PostCommitComment returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestPostCommitCommentWithoutLine(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/18f3e63d05582537db6d183d9d557be09e1f90c8/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"note":"Looks good"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"note": "Looks good"}`)
+	})
+
+	_, _, err := client.Commits.PostCommitComment(1, "18f3e63d05582537db6d183d9d557be09e1f90c8", &PostCommitCommentOptions{Note: String("Looks good")})
+	if err != nil {
+		t.Fatalf("Commits.PostCommitComment returned error: %v", err)
+	}
+}
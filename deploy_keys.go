@@ -31,23 +31,49 @@ type DeployKeysService struct {
 
 // DeployKey represents a GitLab deploy key.
 type DeployKey struct {
-	ID        int        `json:"id"`
-	Title     string     `json:"title"`
-	Key       string     `json:"key"`
-	CanPush   *bool      `json:"can_push"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID                      int                 `json:"id"`
+	Title                   string              `json:"title"`
+	Key                     string              `json:"key"`
+	Fingerprint             string              `json:"fingerprint"`
+	FingerprintSHA256       string              `json:"fingerprint_sha256"`
+	CanPush                 *bool               `json:"can_push"`
+	CreatedAt               *time.Time          `json:"created_at"`
+	ProjectsWithWriteAccess []*DeployKeyProject `json:"projects_with_write_access"`
+}
+
+// DeployKeyProject represents a project a deploy key has write access to.
+type DeployKeyProject struct {
+	ID                int        `json:"id"`
+	Description       string     `json:"description"`
+	Name              string     `json:"name"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	Path              string     `json:"path"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	CreatedAt         *time.Time `json:"created_at"`
 }
 
 func (k DeployKey) String() string {
 	return Stringify(k)
 }
 
-// ListAllDeployKeys gets a list of all deploy keys
+// ListAllDeployKeysOptions represents the available ListAllDeployKeys()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/deploy_keys.html#list-all-deploy-keys
+type ListAllDeployKeysOptions struct {
+	ListOptions
+	Public *bool `url:"public,omitempty" json:"public,omitempty"`
+}
+
+// ListAllDeployKeys gets a list of all deploy keys on the instance. This
+// requires administrator access. Each key includes the projects it has
+// write access to.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/deploy_keys.html#list-all-deploy-keys
-func (s *DeployKeysService) ListAllDeployKeys(options ...RequestOptionFunc) ([]*DeployKey, *Response, error) {
-	req, err := s.client.NewRequest("GET", "deploy_keys", nil, options)
+func (s *DeployKeysService) ListAllDeployKeys(opt *ListAllDeployKeysOptions, options ...RequestOptionFunc) ([]*DeployKey, *Response, error) {
+	req, err := s.client.NewRequest("GET", "deploy_keys", opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -174,7 +200,8 @@ func (s *DeployKeysService) DeleteDeployKey(pid interface{}, deployKey int, opti
 	return s.client.Do(req, nil)
 }
 
-// EnableDeployKey enables a deploy key.
+// EnableDeployKey enables an existing deploy key for the given project, so
+// a key already known to GitLab can be attached to additional projects.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/deploy_keys.html#enable-deploy-key
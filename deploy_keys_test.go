@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListAllDeployKeys(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/deploy_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/deploy_keys?page=1&per_page=20&public=true")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"title": "Public key",
+			"key": "ssh-rsa AAAA",
+			"fingerprint": "4a:9d:64:15:ed:3a:e6:07:6e:89:36:b3:3b:03:05:d9",
+			"fingerprint_sha256": "SHA256:Jrs3LD1Ji30xNLtTVf9NDCj7kkBgPBb2pjvTZ3HfIgU",
+			"created_at": "2013-10-02T10:12:29Z",
+			"projects_with_write_access": [{
+				"id": 73,
+				"description": null,
+				"name": "project2",
+				"name_with_namespace": "Sidney Jones / project2",
+				"path": "project2",
+				"path_with_namespace": "sidney_jones/project2",
+				"created_at": "2021-10-25T18:33:17.550Z"
+			}]
+		}]`)
+	})
+
+	opt := &ListAllDeployKeysOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 20},
+		Public:      Bool(true),
+	}
+	keys, _, err := client.DeployKeys.ListAllDeployKeys(opt)
+	if err != nil {
+		t.Fatalf("DeployKeys.ListAllDeployKeys returned error: %v", err)
+	}
+
+	createdAt := time.Date(2013, 10, 2, 10, 12, 29, 0, time.UTC)
+	projectCreatedAt := time.Date(2021, 10, 25, 18, 33, 17, 550000000, time.UTC)
+	want := []*DeployKey{{
+		ID:                1,
+		Title:             "Public key",
+		Key:               "ssh-rsa AAAA",
+		Fingerprint:       "4a:9d:64:15:ed:3a:e6:07:6e:89:36:b3:3b:03:05:d9",
+		FingerprintSHA256: "SHA256:Jrs3LD1Ji30xNLtTVf9NDCj7kkBgPBb2pjvTZ3HfIgU",
+		CreatedAt:         &createdAt,
+		ProjectsWithWriteAccess: []*DeployKeyProject{{
+			ID:                73,
+			Name:              "project2",
+			NameWithNamespace: "Sidney Jones / project2",
+			Path:              "project2",
+			PathWithNamespace: "sidney_jones/project2",
+			CreatedAt:         &projectCreatedAt,
+		}},
+	}}
+	if !reflect.DeepEqual(want, keys) {
+		t.Errorf("DeployKeys.ListAllDeployKeys returned %+v, want %+v", keys, want)
+	}
+}
+
+func TestEnableDeployKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/deploy_keys/12/enable", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":12,"title":"Ubuntu @ workstation","key":"ssh-rsa AAAA","created_at":"2015-08-29T12:44:31.550Z"}`)
+	})
+
+	key, _, err := client.DeployKeys.EnableDeployKey(5, 12)
+	if err != nil {
+		t.Fatalf("DeployKeys.EnableDeployKey returned error: %v", err)
+	}
+
+	createdAt := time.Date(2015, 8, 29, 12, 44, 31, 550000000, time.UTC)
+	want := &DeployKey{ID: 12, Title: "Ubuntu @ workstation", Key: "ssh-rsa AAAA", CreatedAt: &createdAt}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("DeployKeys.EnableDeployKey returned %+v, want %+v", key, want)
+	}
+}
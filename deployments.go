@@ -34,6 +34,7 @@ type Deployment struct {
 	IID         int          `json:"iid"`
 	Ref         string       `json:"ref"`
 	SHA         string       `json:"sha"`
+	Status      string       `json:"status"`
 	CreatedAt   *time.Time   `json:"created_at"`
 	UpdatedAt   *time.Time   `json:"updated_at"`
 	User        *ProjectUser `json:"user"`
@@ -68,12 +69,14 @@ type Deployment struct {
 // https://docs.gitlab.com/ce/api/deployments.html#list-project-deployments
 type ListProjectDeploymentsOptions struct {
 	ListOptions
-	OrderBy       *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort          *string    `url:"sort,omitempty" json:"sort,omitempty"`
-	UpdatedAfter  *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore *time.Time `url:"update_before,omitempty" json:"updated_before,omitempty"`
-	Environment   *string    `url:"environment,omitempty" json:"environment,omitempty"`
-	Status        *string    `url:"status,omitempty" json:"status,omitempty"`
+	OrderBy        *string                `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort           *string                `url:"sort,omitempty" json:"sort,omitempty"`
+	UpdatedAfter   *time.Time             `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore  *time.Time             `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	FinishedAfter  *time.Time             `url:"finished_after,omitempty" json:"finished_after,omitempty"`
+	FinishedBefore *time.Time             `url:"finished_before,omitempty" json:"finished_before,omitempty"`
+	Environment    *string                `url:"environment,omitempty" json:"environment,omitempty"`
+	Status         *DeploymentStatusValue `url:"status,omitempty" json:"status,omitempty"`
 }
 
 // ListProjectDeployments gets a list of deployments in a project.
@@ -152,7 +155,7 @@ func (s *DeploymentsService) CreateProjectDeployment(pid interface{}, opt *Creat
 	}
 
 	d := new(Deployment)
-	resp, err := s.client.Do(req, &d)
+	resp, err := s.client.Do(req, d)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -184,10 +187,36 @@ func (s *DeploymentsService) UpdateProjectDeployment(pid interface{}, deployment
 	}
 
 	d := new(Deployment)
-	resp, err := s.client.Do(req, &d)
+	resp, err := s.client.Do(req, d)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return d, resp, err
 }
+
+// ListDeploymentMergeRequests gets the merge requests shipped with a given
+// deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#list-of-merge-requests-associated-with-a-deployment
+func (s *DeploymentsService) ListDeploymentMergeRequests(pid interface{}, deployment int, opts *ListMergeRequestsOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/deployments/%d/merge_requests", pathEscape(project), deployment)
+
+	req, err := s.client.NewRequest("GET", u, opts, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mrs []*MergeRequest
+	resp, err := s.client.Do(req, &mrs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mrs, resp, err
+}
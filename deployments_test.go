@@ -0,0 +1,121 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListProjectDeployments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/deployments?environment=production&finished_after=2020-01-01T00%3A00%3A00Z&status=success&updated_before=2020-02-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[{"id":41,"iid":1,"ref":"master","sha":"99d03678b90d914dbb1b109132516d71a4a03ea8","status":"success","environment":{"id":9,"name":"production"}}]`)
+	})
+
+	finishedAfter := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedBefore := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListProjectDeploymentsOptions{
+		Environment:   String("production"),
+		Status:        DeploymentStatus(DeploymentStatusSuccess),
+		FinishedAfter: &finishedAfter,
+		UpdatedBefore: &updatedBefore,
+	}
+
+	deployments, _, err := client.Deployments.ListProjectDeployments(1, opt)
+	if err != nil {
+		t.Fatalf("Deployments.ListProjectDeployments returned error: %v", err)
+	}
+
+	want := []*Deployment{{
+		ID:          41,
+		IID:         1,
+		Ref:         "master",
+		SHA:         "99d03678b90d914dbb1b109132516d71a4a03ea8",
+		Status:      "success",
+		Environment: &Environment{ID: 9, Name: "production"},
+	}}
+	if !reflect.DeepEqual(want, deployments) {
+		t.Errorf("Deployments.ListProjectDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
+func TestCreateProjectDeployment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment":"production","ref":"master","sha":"a91957a858320c0e17f3a0eca7cfacbff50ea29a","tag":false,"status":"running"}`)
+		fmt.Fprint(w, `{"id":42,"iid":2,"ref":"master","sha":"a91957a858320c0e17f3a0eca7cfacbff50ea29a","status":"running"}`)
+	})
+
+	opt := &CreateProjectDeploymentOptions{
+		Environment: String("production"),
+		Ref:         String("master"),
+		SHA:         String("a91957a858320c0e17f3a0eca7cfacbff50ea29a"),
+		Tag:         Bool(false),
+		Status:      DeploymentStatus(DeploymentStatusRunning),
+	}
+
+	deployment, _, err := client.Deployments.CreateProjectDeployment(1, opt)
+	if err != nil {
+		t.Fatalf("Deployments.CreateProjectDeployment returned error: %v", err)
+	}
+
+	want := &Deployment{ID: 42, IID: 2, Ref: "master", SHA: "a91957a858320c0e17f3a0eca7cfacbff50ea29a", Status: "running"}
+	if !reflect.DeepEqual(want, deployment) {
+		t.Errorf("Deployments.CreateProjectDeployment returned %+v, want %+v", deployment, want)
+	}
+}
+
+func TestUpdateProjectDeployment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"status":"success"}`)
+		fmt.Fprint(w, `{"id":42,"iid":2,"ref":"master","status":"success"}`)
+	})
+
+	opt := &UpdateProjectDeploymentOptions{Status: DeploymentStatus(DeploymentStatusSuccess)}
+
+	deployment, _, err := client.Deployments.UpdateProjectDeployment(1, 42, opt)
+	if err != nil {
+		t.Fatalf("Deployments.UpdateProjectDeployment returned error: %v", err)
+	}
+
+	want := &Deployment{ID: 42, IID: 2, Ref: "master", Status: "success"}
+	if !reflect.DeepEqual(want, deployment) {
+		t.Errorf("Deployments.UpdateProjectDeployment returned %+v, want %+v", deployment, want)
+	}
+}
+
+func TestListDeploymentMergeRequests(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments/42/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/deployments/42/merge_requests?state=merged")
+		fmt.Fprint(w, `[{"id":1,"iid":1,"project_id":1,"title":"Add changelog","state":"merged"}]`)
+	})
+
+	opt := &ListMergeRequestsOptions{State: String("merged")}
+
+	mrs, _, err := client.Deployments.ListDeploymentMergeRequests(1, 42, opt)
+	if err != nil {
+		t.Fatalf("Deployments.ListDeploymentMergeRequests returned error: %v", err)
+	}
+
+	want := []*MergeRequest{{ID: 1, IID: 1, ProjectID: 1, Title: "Add changelog", State: "merged"}}
+	if !reflect.DeepEqual(want, mrs) {
+		t.Errorf("Deployments.ListDeploymentMergeRequests returned %+v, want %+v", mrs, want)
+	}
+}
@@ -350,8 +350,8 @@ type AddSnippetDiscussionNoteOptions struct {
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
 }
 
-// AddSnippetDiscussionNote creates a new discussion to a single project
-// snippet.
+// AddSnippetDiscussionNote creates a new note in an existing project snippet
+// discussion.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/discussions.html#add-note-to-existing-snippet-thread
@@ -513,7 +513,7 @@ func (s *DiscussionsService) GetEpicDiscussion(gid interface{}, epic int, discus
 // options.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/discussions.html#add-note-to-existing-epic-thread
+// https://docs.gitlab.com/ce/api/discussions.html#create-new-epic-thread
 type CreateEpicDiscussionOptions struct {
 	Body      *string    `url:"body,omitempty" json:"body,omitempty"`
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
@@ -523,7 +523,7 @@ type CreateEpicDiscussionOptions struct {
 // discussions are comments users can post to a epic.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/discussions.html#add-note-to-existing-epic-thread
+// https://docs.gitlab.com/ce/api/discussions.html#create-new-epic-thread
 func (s *DiscussionsService) CreateEpicDiscussion(gid interface{}, epic int, opt *CreateEpicDiscussionOptions, options ...RequestOptionFunc) (*Discussion, *Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
@@ -558,7 +558,7 @@ type AddEpicDiscussionNoteOptions struct {
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
 }
 
-// AddEpicDiscussionNote creates a new discussion to a single project epic.
+// AddEpicDiscussionNote creates a new note in an existing epic discussion.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/discussions.html#add-note-to-existing-epic-thread
@@ -729,7 +729,8 @@ type CreateMergeRequestDiscussionOptions struct {
 }
 
 // CreateMergeRequestDiscussion creates a new discussion for a single merge
-// request.
+// request. Set the position to start a discussion on a line of the diff;
+// multi-line comments additionally need the line range of the position.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/discussions.html#create-new-merge-request-thread
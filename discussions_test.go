@@ -0,0 +1,270 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListIssueDiscussions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/issues/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/issues/11/discussions?page=1&per_page=10")
+		fmt.Fprint(w, `[{"id":"6a9c1750b37d513a43987b574953fceb50b03ce7","individual_note":false,"notes":[{"id":1126,"body":"discussion text","noteable_type":"Issue","noteable_id":3,"noteable_iid":11}]}]`)
+	})
+
+	opt := &ListIssueDiscussionsOptions{Page: 1, PerPage: 10}
+	discussions, _, err := client.Discussions.ListIssueDiscussions(5, 11, opt)
+	if err != nil {
+		t.Fatalf("Discussions.ListIssueDiscussions returned error: %v", err)
+	}
+
+	want := []*Discussion{{
+		ID: "6a9c1750b37d513a43987b574953fceb50b03ce7",
+		Notes: []*Note{{
+			ID:           1126,
+			Body:         "discussion text",
+			NoteableType: "Issue",
+			NoteableID:   3,
+			NoteableIID:  11,
+		}},
+	}}
+	if !reflect.DeepEqual(want, discussions) {
+		t.Errorf("Discussions.ListIssueDiscussions returned %+v, want %+v", discussions, want)
+	}
+}
+
+func TestAddCommitDiscussionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/repository/commits/abc123/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"reply"}`)
+		fmt.Fprint(w, `{"id":1127,"body":"reply","noteable_type":"Commit"}`)
+	})
+
+	opt := &AddCommitDiscussionNoteOptions{Body: String("reply")}
+	note, _, err := client.Discussions.AddCommitDiscussionNote(5, "abc123", "6a9c1750b37d513a43987b574953fceb50b03ce7", opt)
+	if err != nil {
+		t.Fatalf("Discussions.AddCommitDiscussionNote returned error: %v", err)
+	}
+
+	want := &Note{ID: 1127, Body: "reply", NoteableType: "Commit"}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("Discussions.AddCommitDiscussionNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestCreateMergeRequestDiscussionWithPosition(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Consider extracting this","position":{"base_sha":"aaa","start_sha":"bbb","head_sha":"ccc","position_type":"text","new_path":"main.go","new_line":12,"old_path":"main.go","line_range":{"start":{"line_code":"abc_10_10","type":"new","new_line":10},"end":{"line_code":"abc_12_12","type":"new","new_line":12}}}}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"87805b7c09016a7058e91bdbe7b29d1f284a39e6","individual_note":false,"notes":[{"id":1128,"type":"DiffNote","body":"Consider extracting this","resolvable":true,"position":{"base_sha":"aaa","start_sha":"bbb","head_sha":"ccc","position_type":"text","new_path":"main.go","new_line":12,"old_path":"main.go","old_line":null}}]}`)
+	})
+
+	opt := &CreateMergeRequestDiscussionOptions{
+		Body: String("Consider extracting this"),
+		Position: &NotePosition{
+			BaseSHA:      "aaa",
+			StartSHA:     "bbb",
+			HeadSHA:      "ccc",
+			PositionType: "text",
+			NewPath:      "main.go",
+			NewLine:      12,
+			OldPath:      "main.go",
+			LineRange: &LineRange{
+				Start: &LinePosition{LineCode: "abc_10_10", Type: "new", NewLine: 10},
+				End:   &LinePosition{LineCode: "abc_12_12", Type: "new", NewLine: 12},
+			},
+		},
+	}
+	discussion, _, err := client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	if err != nil {
+		t.Fatalf("Discussions.CreateMergeRequestDiscussion returned error: %v", err)
+	}
+
+	want := &Discussion{
+		ID: "87805b7c09016a7058e91bdbe7b29d1f284a39e6",
+		Notes: []*Note{{
+			ID:         1128,
+			Body:       "Consider extracting this",
+			Resolvable: true,
+			Position: &NotePosition{
+				BaseSHA:      "aaa",
+				StartSHA:     "bbb",
+				HeadSHA:      "ccc",
+				PositionType: "text",
+				NewPath:      "main.go",
+				NewLine:      12,
+				OldPath:      "main.go",
+			},
+		}},
+	}
+	if !reflect.DeepEqual(want, discussion) {
+		t.Errorf("Discussions.CreateMergeRequestDiscussion returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestResolveMergeRequestDiscussion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"resolved":true}`)
+		fmt.Fprint(w, `{"id":"87805b7c09016a7058e91bdbe7b29d1f284a39e6","individual_note":false,"notes":[{"id":1128,"resolvable":true,"resolved":true}]}`)
+	})
+
+	opt := &ResolveMergeRequestDiscussionOptions{Resolved: Bool(true)}
+	discussion, _, err := client.Discussions.ResolveMergeRequestDiscussion(5, 11, "87805b7c09016a7058e91bdbe7b29d1f284a39e6", opt)
+	if err != nil {
+		t.Fatalf("Discussions.ResolveMergeRequestDiscussion returned error: %v", err)
+	}
+
+	want := &Discussion{
+		ID:    "87805b7c09016a7058e91bdbe7b29d1f284a39e6",
+		Notes: []*Note{{ID: 1128, Resolvable: true, Resolved: true}},
+	}
+	if !reflect.DeepEqual(want, discussion) {
+		t.Errorf("Discussions.ResolveMergeRequestDiscussion returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestGetSnippetDiscussion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/snippets/3/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"6a9c1750b37d513a43987b574953fceb50b03ce7","individual_note":true,"notes":[{"id":99,"body":"snippet note","noteable_type":"Snippet"}]}`)
+	})
+
+	discussion, _, err := client.Discussions.GetSnippetDiscussion(5, 3, "6a9c1750b37d513a43987b574953fceb50b03ce7")
+	if err != nil {
+		t.Fatalf("Discussions.GetSnippetDiscussion returned error: %v", err)
+	}
+
+	want := &Discussion{
+		ID:             "6a9c1750b37d513a43987b574953fceb50b03ce7",
+		IndividualNote: true,
+		Notes:          []*Note{{ID: 99, Body: "snippet note", NoteableType: "Snippet"}},
+	}
+	if !reflect.DeepEqual(want, discussion) {
+		t.Errorf("Discussions.GetSnippetDiscussion returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestCreateEpicDiscussion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/epics/12/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Weekly status"}`)
+		fmt.Fprint(w, `{"id":"87805b7c09016a7058e91bdbe7b29d1f284a39e6","individual_note":false,"notes":[{"id":1126,"body":"Weekly status","noteable_type":"Epic","noteable_id":12}]}`)
+	})
+
+	d, _, err := client.Discussions.CreateEpicDiscussion("my-group", 12, &CreateEpicDiscussionOptions{Body: String("Weekly status")})
+	if err != nil {
+		t.Fatalf("Discussions.CreateEpicDiscussion returned error: %v", err)
+	}
+
+	want := &Discussion{
+		ID:    "87805b7c09016a7058e91bdbe7b29d1f284a39e6",
+		Notes: []*Note{{ID: 1126, Body: "Weekly status", NoteableType: "Epic", NoteableID: 12}},
+	}
+	if !reflect.DeepEqual(want, d) {
+		t.Errorf("Discussions.CreateEpicDiscussion returned %+v, want %+v", d, want)
+	}
+}
+
+func TestAddEpicDiscussionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/epics/12/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Follow-up"}`)
+		fmt.Fprint(w, `{"id":1127,"body":"Follow-up","noteable_type":"Epic","noteable_id":12}`)
+	})
+
+	opt := &AddEpicDiscussionNoteOptions{Body: String("Follow-up")}
+	note, _, err := client.Discussions.AddEpicDiscussionNote("my-group", 12, "87805b7c09016a7058e91bdbe7b29d1f284a39e6", opt)
+	if err != nil {
+		t.Fatalf("Discussions.AddEpicDiscussionNote returned error: %v", err)
+	}
+
+	want := &Note{ID: 1127, Body: "Follow-up", NoteableType: "Epic", NoteableID: 12}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("Discussions.AddEpicDiscussionNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestListSnippetDiscussions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/snippets/8/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"a1b2c3","individual_note":true,"notes":[{"id":1,"body":"Nice snippet","noteable_type":"Snippet","noteable_id":8}]}]`)
+	})
+
+	ds, _, err := client.Discussions.ListSnippetDiscussions(5, 8, nil)
+	if err != nil {
+		t.Fatalf("Discussions.ListSnippetDiscussions returned error: %v", err)
+	}
+
+	want := []*Discussion{{
+		ID:             "a1b2c3",
+		IndividualNote: true,
+		Notes:          []*Note{{ID: 1, Body: "Nice snippet", NoteableType: "Snippet", NoteableID: 8}},
+	}}
+	if !reflect.DeepEqual(want, ds) {
+		t.Errorf("Discussions.ListSnippetDiscussions returned %+v, want %+v", ds, want)
+	}
+}
+
+func TestUpdateSnippetDiscussionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/snippets/8/discussions/a1b2c3/notes/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"body":"Nice snippet!"}`)
+		fmt.Fprint(w, `{"id":1,"body":"Nice snippet!","noteable_type":"Snippet","noteable_id":8}`)
+	})
+
+	opt := &UpdateSnippetDiscussionNoteOptions{Body: String("Nice snippet!")}
+	note, _, err := client.Discussions.UpdateSnippetDiscussionNote(5, 8, "a1b2c3", 1, opt)
+	if err != nil {
+		t.Fatalf("Discussions.UpdateSnippetDiscussionNote returned error: %v", err)
+	}
+
+	want := &Note{ID: 1, Body: "Nice snippet!", NoteableType: "Snippet", NoteableID: 8}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("Discussions.UpdateSnippetDiscussionNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestDeleteSnippetDiscussionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/snippets/8/discussions/a1b2c3/notes/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Discussions.DeleteSnippetDiscussionNote(5, 8, "a1b2c3", 1)
+	if err != nil {
+		t.Fatalf("Discussions.DeleteSnippetDiscussionNote returned error: %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListDockerfileTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/dockerfiles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key":"Binary","name":"Binary"},{"key":"Golang","name":"Golang"}]`)
+	})
+
+	templates, _, err := client.DockerfileTemplate.ListTemplates(nil)
+	if err != nil {
+		t.Fatalf("DockerfileTemplate.ListTemplates returned error: %v", err)
+	}
+
+	want := []*DockerfileTemplate{{Key: "Binary", Name: "Binary"}, {Key: "Golang", Name: "Golang"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("DockerfileTemplate.ListTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetDockerfileTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/dockerfiles/Golang", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"Golang","content":"FROM golang:1.11 AS builder\n"}`)
+	})
+
+	template, _, err := client.DockerfileTemplate.GetTemplate("Golang")
+	if err != nil {
+		t.Fatalf("DockerfileTemplate.GetTemplate returned error: %v", err)
+	}
+
+	want := &DockerfileTemplate{Name: "Golang", Content: "FROM golang:1.11 AS builder\n"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("DockerfileTemplate.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
@@ -0,0 +1,235 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+)
+
+// DraftNotesService handles communication with the draft notes related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/draft_notes.html
+type DraftNotesService struct {
+	client *Client
+}
+
+// DraftNote represents a GitLab draft note. Draft notes are pending review
+// comments that are only visible to their author until they are published.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/draft_notes.html
+type DraftNote struct {
+	ID                int           `json:"id"`
+	AuthorID          int           `json:"author_id"`
+	MergeRequestID    int           `json:"merge_request_id"`
+	ResolveDiscussion bool          `json:"resolve_discussion"`
+	DiscussionID      string        `json:"discussion_id"`
+	Note              string        `json:"note"`
+	CommitID          string        `json:"commit_id"`
+	LineCode          string        `json:"line_code"`
+	Position          *NotePosition `json:"position"`
+}
+
+func (n DraftNote) String() string {
+	return Stringify(n)
+}
+
+// ListDraftNotesOptions represents the available ListDraftNotes() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#list-all-merge-request-draft-notes
+type ListDraftNotesOptions ListOptions
+
+// ListDraftNotes gets a list of all draft notes for a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#list-all-merge-request-draft-notes
+func (s *DraftNotesService) ListDraftNotes(pid interface{}, mergeRequest int, opt *ListDraftNotesOptions, options ...RequestOptionFunc) ([]*DraftNote, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var n []*DraftNote
+	resp, err := s.client.Do(req, &n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// GetDraftNote gets a single draft note for a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#get-a-single-draft-note
+func (s *DraftNotesService) GetDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pathEscape(project), mergeRequest, note)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(DraftNote)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// CreateDraftNoteOptions represents the available CreateDraftNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
+type CreateDraftNoteOptions struct {
+	Note                  *string       `url:"note,omitempty" json:"note,omitempty"`
+	CommitID              *string       `url:"commit_id,omitempty" json:"commit_id,omitempty"`
+	InReplyToDiscussionID *string       `url:"in_reply_to_discussion_id,omitempty" json:"in_reply_to_discussion_id,omitempty"`
+	ResolveDiscussion     *bool         `url:"resolve_discussion,omitempty" json:"resolve_discussion,omitempty"`
+	Position              *NotePosition `url:"position,omitempty" json:"position,omitempty"`
+}
+
+// CreateDraftNote creates a draft note for a merge request. Set Position to
+// create an inline comment on the diff.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
+func (s *DraftNotesService) CreateDraftNote(pid interface{}, mergeRequest int, opt *CreateDraftNoteOptions, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(DraftNote)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// UpdateDraftNoteOptions represents the available UpdateDraftNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#update-a-draft-note
+type UpdateDraftNoteOptions struct {
+	Note     *string       `url:"note,omitempty" json:"note,omitempty"`
+	Position *NotePosition `url:"position,omitempty" json:"position,omitempty"`
+}
+
+// UpdateDraftNote updates a draft note for a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#update-a-draft-note
+func (s *DraftNotesService) UpdateDraftNote(pid interface{}, mergeRequest int, note int, opt *UpdateDraftNoteOptions, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pathEscape(project), mergeRequest, note)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(DraftNote)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// DeleteDraftNote deletes a draft note for a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#delete-a-draft-note
+func (s *DraftNotesService) DeleteDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d", pathEscape(project), mergeRequest, note)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// PublishDraftNote publishes a single draft note for a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#publish-a-draft-note
+func (s *DraftNotesService) PublishDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/%d/publish", pathEscape(project), mergeRequest, note)
+
+	req, err := s.client.NewRequest("PUT", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// BulkPublishAllDraftNotes publishes all draft notes of the current user for
+// a merge request at once, sending a single notification for the review.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#publish-all-pending-draft-notes
+func (s *DraftNotesService) BulkPublishAllDraftNotes(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/draft_notes/bulk_publish", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
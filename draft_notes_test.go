@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListDraftNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":5,"author_id":23,"merge_request_id":11,"resolve_discussion":false,"discussion_id":null,"note":"Example title","commit_id":null,"line_code":null,"position":null}]`)
+	})
+
+	notes, _, err := client.DraftNotes.ListDraftNotes(1, 4, nil)
+	if err != nil {
+		t.Fatalf("DraftNotes.ListDraftNotes returned error: %v", err)
+	}
+
+	want := []*DraftNote{{ID: 5, AuthorID: 23, MergeRequestID: 11, Note: "Example title"}}
+	if !reflect.DeepEqual(want, notes) {
+		t.Errorf("DraftNotes.ListDraftNotes returned %+v, want %+v", notes, want)
+	}
+}
+
+func TestCreateDraftNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"note":"Use a constant here","resolve_discussion":true,"position":{"base_sha":"aa","start_sha":"bb","head_sha":"cc","position_type":"text","new_path":"main.go","new_line":12}}`)
+		fmt.Fprint(w, `{"id":6,"merge_request_id":11,"resolve_discussion":true,"note":"Use a constant here","position":{"base_sha":"aa","start_sha":"bb","head_sha":"cc","position_type":"text","new_path":"main.go","new_line":12}}`)
+	})
+
+	position := &NotePosition{
+		BaseSHA:      "aa",
+		StartSHA:     "bb",
+		HeadSHA:      "cc",
+		PositionType: "text",
+		NewPath:      "main.go",
+		NewLine:      12,
+	}
+	opt := &CreateDraftNoteOptions{
+		Note:              String("Use a constant here"),
+		ResolveDiscussion: Bool(true),
+		Position:          position,
+	}
+	note, _, err := client.DraftNotes.CreateDraftNote(1, 4, opt)
+	if err != nil {
+		t.Fatalf("DraftNotes.CreateDraftNote returned error: %v", err)
+	}
+
+	want := &DraftNote{
+		ID:                6,
+		MergeRequestID:    11,
+		ResolveDiscussion: true,
+		Note:              "Use a constant here",
+		Position:          position,
+	}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("DraftNotes.CreateDraftNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestUpdateDraftNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"note":"Updated"}`)
+		fmt.Fprint(w, `{"id":6,"note":"Updated"}`)
+	})
+
+	note, _, err := client.DraftNotes.UpdateDraftNote(1, 4, 6, &UpdateDraftNoteOptions{Note: String("Updated")})
+	if err != nil {
+		t.Fatalf("DraftNotes.UpdateDraftNote returned error: %v", err)
+	}
+
+	want := &DraftNote{ID: 6, Note: "Updated"}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("DraftNotes.UpdateDraftNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestDeleteDraftNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.DraftNotes.DeleteDraftNote(1, 4, 6)
+	if err != nil {
+		t.Fatalf("DraftNotes.DeleteDraftNote returned error: %v", err)
+	}
+}
+
+func TestPublishDraftNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes/6/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.DraftNotes.PublishDraftNote(1, 4, 6)
+	if err != nil {
+		t.Fatalf("DraftNotes.PublishDraftNote returned error: %v", err)
+	}
+}
+
+func TestBulkPublishAllDraftNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/draft_notes/bulk_publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.DraftNotes.BulkPublishAllDraftNotes(1, 4)
+	if err != nil {
+		t.Fatalf("DraftNotes.BulkPublishAllDraftNotes returned error: %v", err)
+	}
+}
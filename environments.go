@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"fmt"
+	"time"
 )
 
 // EnvironmentsService handles communication with the environment related methods
@@ -32,13 +33,16 @@ type EnvironmentsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/environments.html
 type Environment struct {
-	ID             int         `json:"id"`
-	Name           string      `json:"name"`
-	Slug           string      `json:"slug"`
-	State          string      `json:"state"`
-	ExternalURL    string      `json:"external_url"`
-	Project        *Project    `json:"project"`
-	LastDeployment *Deployment `json:"last_deployment"`
+	ID             int                  `json:"id"`
+	Name           string               `json:"name"`
+	Slug           string               `json:"slug"`
+	State          string               `json:"state"`
+	Tier           EnvironmentTierValue `json:"tier"`
+	ExternalURL    string               `json:"external_url"`
+	Project        *Project             `json:"project"`
+	CreatedAt      *time.Time           `json:"created_at"`
+	UpdatedAt      *time.Time           `json:"updated_at"`
+	LastDeployment *Deployment          `json:"last_deployment"`
 }
 
 func (env Environment) String() string {
@@ -49,7 +53,12 @@ func (env Environment) String() string {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#list-environments
-type ListEnvironmentsOptions ListOptions
+type ListEnvironmentsOptions struct {
+	ListOptions
+	Name   *string `url:"name,omitempty" json:"name,omitempty"`
+	Search *string `url:"search,omitempty" json:"search,omitempty"`
+	States *string `url:"states,omitempty" json:"states,omitempty"`
+}
 
 // ListEnvironments gets a list of environments from a project, sorted by name
 // alphabetically.
@@ -107,8 +116,9 @@ func (s *EnvironmentsService) GetEnvironment(pid interface{}, environment int, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#create-a-new-environment
 type CreateEnvironmentOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	ExternalURL *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Name        *string               `url:"name,omitempty" json:"name,omitempty"`
+	ExternalURL *string               `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Tier        *EnvironmentTierValue `url:"tier,omitempty" json:"tier,omitempty"`
 }
 
 // CreateEnvironment adds an environment to a project. This is an idempotent
@@ -144,8 +154,9 @@ func (s *EnvironmentsService) CreateEnvironment(pid interface{}, opt *CreateEnvi
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#edit-an-existing-environment
 type EditEnvironmentOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	ExternalURL *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Name        *string               `url:"name,omitempty" json:"name,omitempty"`
+	ExternalURL *string               `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Tier        *EnvironmentTierValue `url:"tier,omitempty" json:"tier,omitempty"`
 }
 
 // EditEnvironment updates a project team environment to a specified access level..
@@ -192,21 +203,83 @@ func (s *EnvironmentsService) DeleteEnvironment(pid interface{}, environment int
 	return s.client.Do(req, nil)
 }
 
+// StopEnvironmentOptions represents the available StopEnvironment() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/environments.html#stop-an-environment
+type StopEnvironmentOptions struct {
+	Force *bool `url:"force,omitempty" json:"force,omitempty"`
+}
+
 // StopEnvironment stop an environment from a project team.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/environments.html#stop-an-environment
-func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int, options ...RequestOptionFunc) (*Response, error) {
+func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int, opt *StopEnvironmentOptions, options ...RequestOptionFunc) (*Environment, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/environments/%d/stop", pathEscape(project), environmentID)
 
-	req, err := s.client.NewRequest("POST", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	env := new(Environment)
+	resp, err := s.client.Do(req, env)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return env, resp, err
+}
+
+// DeleteStoppedEnvironmentsOptions represents the available
+// DeleteStoppedEnvironments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/environments.html#delete-multiple-stopped-review-apps
+type DeleteStoppedEnvironmentsOptions struct {
+	Before *time.Time `url:"before,omitempty" json:"before,omitempty"`
+	Limit  *int       `url:"limit,omitempty" json:"limit,omitempty"`
+	DryRun *bool      `url:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// DeletedEnvironments represents the result of a DeleteStoppedEnvironments()
+// call.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/environments.html#delete-multiple-stopped-review-apps
+type DeletedEnvironments struct {
+	ScheduledEntries     []*Environment `json:"scheduled_entries"`
+	UnprocessableEntries []*Environment `json:"unprocessable_entries"`
+}
+
+// DeleteStoppedEnvironments schedules the deletion of stopped review app
+// environments of a project. By default this is a dry run, which only
+// returns the environments that would be deleted.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/environments.html#delete-multiple-stopped-review-apps
+func (s *EnvironmentsService) DeleteStoppedEnvironments(pid interface{}, opt *DeleteStoppedEnvironmentsOptions, options ...RequestOptionFunc) (*DeletedEnvironments, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/review_apps", pathEscape(project))
+
+	req, err := s.client.NewRequest("DELETE", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DeletedEnvironments)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
 }
@@ -21,7 +21,7 @@ func TestListEnvironments(t *testing.T) {
 		fmt.Fprint(w, `[{"id": 1,"name": "review/fix-foo", "slug": "review-fix-foo-dfjre3", "external_url": "https://review-fix-foo-dfjre3.example.gitlab.com"}]`)
 	})
 
-	envs, _, err := client.Environments.ListEnvironments(1, &ListEnvironmentsOptions{Page: 1, PerPage: 10})
+	envs, _, err := client.Environments.ListEnvironments(1, &ListEnvironmentsOptions{ListOptions: ListOptions{Page: 1, PerPage: 10}})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -32,6 +32,31 @@ func TestListEnvironments(t *testing.T) {
 	}
 }
 
+func TestListEnvironmentsWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/environments?search=review&states=stopped")
+		fmt.Fprint(w, `[{"id": 1,"name": "review/fix-foo", "state": "stopped", "tier": "development"}]`)
+	})
+
+	opt := &ListEnvironmentsOptions{
+		Search: String("review"),
+		States: String("stopped"),
+	}
+	envs, _, err := client.Environments.ListEnvironments(1, opt)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := []*Environment{{ID: 1, Name: "review/fix-foo", State: "stopped", Tier: DevelopmentEnvironmentTier}}
+	if !reflect.DeepEqual(want, envs) {
+		t.Errorf("Environments.ListEnvironments returned %+v, want %+v", envs, want)
+	}
+}
+
 func TestGetEnvironment(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -115,11 +140,46 @@ func TestStopEnvironment(t *testing.T) {
 	mux.HandleFunc("/api/v4/projects/1/environments/1/stop", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
 		testURL(t, r, "/api/v4/projects/1/environments/1/stop")
+		testBody(t, r, `{"force":true}`)
+		fmt.Fprint(w, `{"id": 1,"name": "review/fix-foo", "state": "stopped"}`)
 	})
-	_, err := client.Environments.StopEnvironment(1, 1)
+	env, _, err := client.Environments.StopEnvironment(1, 1, &StopEnvironmentOptions{Force: Bool(true)})
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	want := &Environment{ID: 1, Name: "review/fix-foo", State: "stopped"}
+	if !reflect.DeepEqual(want, env) {
+		t.Errorf("Environments.StopEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestDeleteStoppedEnvironments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/review_apps", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/environments/review_apps?dry_run=false&limit=50")
+		fmt.Fprint(w, `{"scheduled_entries":[{"id":387,"name":"review/023f1bce01229c686a73","slug":"review-023f1bce01-3uxznk","external_url":null}],"unprocessable_entries":[]}`)
+	})
+
+	opt := &DeleteStoppedEnvironmentsOptions{
+		Limit:  Int(50),
+		DryRun: Bool(false),
+	}
+	deleted, _, err := client.Environments.DeleteStoppedEnvironments(1, opt)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := &DeletedEnvironments{
+		ScheduledEntries:     []*Environment{{ID: 387, Name: "review/023f1bce01229c686a73", Slug: "review-023f1bce01-3uxznk"}},
+		UnprocessableEntries: []*Environment{},
+	}
+	if !reflect.DeepEqual(want, deleted) {
+		t.Errorf("Environments.DeleteStoppedEnvironments returned %+v, want %+v", deleted, want)
+	}
 }
 
 func TestUnmarshal(t *testing.T) {
@@ -1,9 +1,15 @@
 package gitlab
 
 import (
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"sync"
 )
 
 // EventType represents a Gitlab event type.
@@ -12,6 +18,8 @@ type EventType string
 // List of available event types.
 const (
 	EventTypeBuild         EventType = "Build Hook"
+	EventTypeDeployment    EventType = "Deployment Hook"
+	EventTypeFeatureFlag   EventType = "Feature Flag Hook"
 	EventTypeIssue         EventType = "Issue Hook"
 	EventConfidentialIssue EventType = "Confidential Issue Hook"
 	EventTypeJob           EventType = "Job Hook"
@@ -20,6 +28,7 @@ const (
 	EventConfidentialNote  EventType = "Confidential Note Hook"
 	EventTypePipeline      EventType = "Pipeline Hook"
 	EventTypePush          EventType = "Push Hook"
+	EventTypeRelease       EventType = "Release Hook"
 	EventTypeSystemHook    EventType = "System Hook"
 	EventTypeTagPush       EventType = "Tag Push Hook"
 	EventTypeWikiPage      EventType = "Wiki Page Hook"
@@ -39,7 +48,32 @@ type noteEvent struct {
 	} `json:"object_attributes"`
 }
 
-const eventTypeHeader = "X-Gitlab-Event"
+const (
+	eventTypeHeader = "X-Gitlab-Event"
+	tokenHeader     = "X-Gitlab-Token"
+)
+
+// DefaultMaxWebhookPayloadSize is the maximum number of bytes read from a
+// webhook request body when no other limit is given to ParseWebhookRequest.
+const DefaultMaxWebhookPayloadSize int64 = 25 << 20
+
+// Errors returned by ParseWebhookRequest.
+var (
+	ErrInvalidToken           = errors.New("invalid webhook token")
+	ErrWebhookPayloadTooLarge = errors.New("webhook payload too large")
+)
+
+// UnknownEventTypeError is returned by ParseWebhook when the given event type
+// is not recognized. It keeps the raw payload, so callers can still process
+// events this package does not know about yet.
+type UnknownEventTypeError struct {
+	EventType EventType
+	Payload   []byte
+}
+
+func (e *UnknownEventTypeError) Error() string {
+	return fmt.Sprintf("unexpected event type: %s", e.EventType)
+}
 
 // HookEventType returns the event type for the given request.
 func HookEventType(r *http.Request) EventType {
@@ -73,6 +107,45 @@ func ParseHook(eventType EventType, payload []byte) (event interface{}, err erro
 	}
 }
 
+// ParseWebhookRequest verifies the X-Gitlab-Token header of a webhook request
+// against secret and parses its body using the X-Gitlab-Event header. The
+// token is compared in constant time and ErrInvalidToken is returned when it
+// doesn't match. An empty secret is treated as a configuration error and
+// also returns ErrInvalidToken; use ParseUnverifiedWebhookRequest to
+// explicitly accept requests without a token.
+//
+// At most maxBodySize bytes are read from the body, or
+// DefaultMaxWebhookPayloadSize when maxBodySize is not positive. Larger
+// payloads are rejected with ErrWebhookPayloadTooLarge.
+func ParseWebhookRequest(r *http.Request, secret string, maxBodySize int64) (event interface{}, err error) {
+	token := r.Header.Get(tokenHeader)
+	if secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return nil, ErrInvalidToken
+	}
+
+	return ParseUnverifiedWebhookRequest(r, maxBodySize)
+}
+
+// ParseUnverifiedWebhookRequest parses the body of a webhook request using
+// the X-Gitlab-Event header, like ParseWebhookRequest, but without verifying
+// the X-Gitlab-Token header. Only use it when the request is authenticated
+// in some other way.
+func ParseUnverifiedWebhookRequest(r *http.Request, maxBodySize int64) (event interface{}, err error) {
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxWebhookPayloadSize
+	}
+
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(payload)) > maxBodySize {
+		return nil, ErrWebhookPayloadTooLarge
+	}
+
+	return ParseHook(HookEventType(r), payload)
+}
+
 // ParseSystemhook parses the event payload. For recognized event types, a
 // value of the corresponding struct type will be returned. An error will be
 // returned for unrecognized event types.
@@ -126,7 +199,8 @@ func ParseSystemhook(payload []byte) (event interface{}, err error) {
 	case
 		"user_create",
 		"user_destroy",
-		"user_rename":
+		"user_rename",
+		"user_failed_login":
 		event = &UserSystemEvent{}
 	case
 		"user_add_to_group",
@@ -159,9 +233,89 @@ func WebhookEventType(r *http.Request) EventType {
 	return EventType(r.Header.Get(eventTypeHeader))
 }
 
+// webhookEvents maps the event types known by ParseWebhook to a function
+// returning the value their payload is decoded into. Note hooks are not part
+// of it, as their type depends on the noteable type of the payload.
+var (
+	webhookEventsMu sync.RWMutex
+	webhookEvents   = map[EventType]func() interface{}{
+		EventTypeBuild:         func() interface{} { return &BuildEvent{} },
+		EventTypeDeployment:    func() interface{} { return &DeploymentEvent{} },
+		EventTypeFeatureFlag:   func() interface{} { return &FeatureFlagEvent{} },
+		EventTypeIssue:         func() interface{} { return &IssueEvent{} },
+		EventConfidentialIssue: func() interface{} { return &IssueEvent{} },
+		EventTypeJob:           func() interface{} { return &JobEvent{} },
+		EventTypeMergeRequest:  func() interface{} { return &MergeEvent{} },
+		EventTypePipeline:      func() interface{} { return &PipelineEvent{} },
+		EventTypePush:          func() interface{} { return &PushEvent{} },
+		EventTypeRelease:       func() interface{} { return &ReleaseEvent{} },
+		EventTypeTagPush:       func() interface{} { return &TagEvent{} },
+		EventTypeWikiPage:      func() interface{} { return &WikiPageEvent{} },
+	}
+)
+
+// RegisterEventType registers a custom event type with ParseWebhook. The
+// factory must return a pointer to the value the payload is decoded into.
+// This allows parsing event types this package doesn't know about yet.
+//
+// Registering an event type ParseWebhook already knows replaces the built-in
+// factory, so a registration keeps working when a later release of this
+// package adds native support for the same event type. An error is returned
+// if factory is nil, or for system hook and note events, as those are not
+// parsed using the registered factories.
+func RegisterEventType(eventType EventType, factory func() interface{}) error {
+	if factory == nil {
+		return fmt.Errorf("gitlab: nil factory for event type %s", eventType)
+	}
+
+	switch eventType {
+	case EventTypeSystemHook, EventTypeNote, EventConfidentialNote:
+		return fmt.Errorf("gitlab: event type %s cannot be registered", eventType)
+	}
+
+	webhookEventsMu.Lock()
+	defer webhookEventsMu.Unlock()
+
+	webhookEvents[eventType] = factory
+	return nil
+}
+
+// WebhookEvents returns a copy of the mapping ParseWebhook uses to create
+// the value a payload is decoded into, including any custom event types that
+// have been registered. Note events are not part of it, as the value they
+// are decoded into depends on the noteable type of the payload.
+func WebhookEvents() map[EventType]func() interface{} {
+	webhookEventsMu.RLock()
+	defer webhookEventsMu.RUnlock()
+
+	events := make(map[EventType]func() interface{}, len(webhookEvents))
+	for eventType, factory := range webhookEvents {
+		events[eventType] = factory
+	}
+
+	return events
+}
+
+// WebhookEventTypes returns all event types ParseWebhook can parse,
+// including note events and any custom event types that have been
+// registered.
+func WebhookEventTypes() []EventType {
+	webhookEventsMu.RLock()
+	defer webhookEventsMu.RUnlock()
+
+	types := []EventType{EventTypeNote, EventConfidentialNote}
+	for eventType := range webhookEvents {
+		types = append(types, eventType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
 // ParseWebhook parses the event payload. For recognized event types, a
-// value of the corresponding struct type will be returned. An error will
-// be returned for unrecognized event types.
+// value of the corresponding struct type will be returned. An
+// *UnknownEventTypeError will be returned for unrecognized event types that
+// have not been registered using RegisterEventType.
 //
 // Example usage:
 //
@@ -181,22 +335,6 @@ func WebhookEventType(r *http.Request) EventType {
 //
 func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err error) {
 	switch eventType {
-	case EventTypeBuild:
-		event = &BuildEvent{}
-	case EventTypeIssue, EventConfidentialIssue:
-		event = &IssueEvent{}
-	case EventTypeJob:
-		event = &JobEvent{}
-	case EventTypeMergeRequest:
-		event = &MergeEvent{}
-	case EventTypePipeline:
-		event = &PipelineEvent{}
-	case EventTypePush:
-		event = &PushEvent{}
-	case EventTypeTagPush:
-		event = &TagEvent{}
-	case EventTypeWikiPage:
-		event = &WikiPageEvent{}
 	case EventTypeNote, EventConfidentialNote:
 		note := &noteEvent{}
 		err := json.Unmarshal(payload, note)
@@ -222,7 +360,13 @@ func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err e
 		}
 
 	default:
-		return nil, fmt.Errorf("unexpected event type: %s", eventType)
+		webhookEventsMu.RLock()
+		newEvent, ok := webhookEvents[eventType]
+		webhookEventsMu.RUnlock()
+		if !ok {
+			return nil, &UnknownEventTypeError{EventType: eventType, Payload: payload}
+		}
+		event = newEvent()
 	}
 
 	if err := json.Unmarshal(payload, event); err != nil {
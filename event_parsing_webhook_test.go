@@ -1,6 +1,8 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
 	"net/http"
 	"testing"
 
@@ -52,6 +54,14 @@ func TestParsePushHook(t *testing.T) {
 	if event.Commits[0] == nil || event.Commits[0].Author.Name != "Jordi Mallach" {
 		t.Errorf("Commit Username is %s, want %s", event.UserName, "Jordi Mallach")
 	}
+
+	if event.Project.ID != 15 {
+		t.Errorf("Project.ID is %v, want %v", event.Project.ID, 15)
+	}
+
+	assert.Equal(t, []string{"CHANGELOG"}, event.Commits[0].Added)
+	assert.Equal(t, []string{"app/controller/application.rb"}, event.Commits[0].Modified)
+	assert.Equal(t, []string{}, event.Commits[0].Removed)
 }
 
 func TestParseTagHook(t *testing.T) {
@@ -82,6 +92,14 @@ func TestParseTagHook(t *testing.T) {
 	if event.Ref != "refs/tags/v1.0.0" {
 		t.Errorf("Ref is %s, want %s", event.Ref, "refs/tags/v1.0.0")
 	}
+
+	if event.UserUsername != "jsmith" {
+		t.Errorf("UserUsername is %s, want %s", event.UserUsername, "jsmith")
+	}
+
+	if event.Project.ID != 1 {
+		t.Errorf("Project.ID is %v, want %v", event.Project.ID, 1)
+	}
 }
 
 func TestParseIssueHook(t *testing.T) {
@@ -332,6 +350,48 @@ func TestParsePipelineHook(t *testing.T) {
 	if event.Builds[0].ID != 380 {
 		t.Errorf("Builds[0] ID is %v, want %v", event.Builds[0].ID, 380)
 	}
+
+	if len(event.ObjectAttributes.Variables) != 1 || event.ObjectAttributes.Variables[0].Key != "NESTOR_PROD_ENVIRONMENT" {
+		t.Errorf("Variables is %+v, want a single NESTOR_PROD_ENVIRONMENT variable", event.ObjectAttributes.Variables)
+	}
+}
+
+func TestParseDeploymentHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/deployment.json")
+
+	parsedEvent, err := ParseWebhook("Deployment Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing deployment hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*DeploymentEvent)
+	if !ok {
+		t.Errorf("Expected DeploymentEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.ObjectKind != "deployment" {
+		t.Errorf("ObjectKind is %v, want %v", event.ObjectKind, "deployment")
+	}
+
+	if event.Status != "success" {
+		t.Errorf("Status is %v, want %v", event.Status, "success")
+	}
+
+	if event.Project.ID != 30 {
+		t.Errorf("Project.ID is %v, want %v", event.Project.ID, 30)
+	}
+
+	if event.EnvironmentTier != "staging" {
+		t.Errorf("EnvironmentTier is %v, want %v", event.EnvironmentTier, "staging")
+	}
+
+	if event.Environment != "staging" {
+		t.Errorf("Environment is %v, want %v", event.Environment, "staging")
+	}
+
+	if event.User.Username != "root" {
+		t.Errorf("User.Username is %v, want %v", event.User.Username, "root")
+	}
 }
 
 func TestParseBuildHook(t *testing.T) {
@@ -375,3 +435,307 @@ func TestParseHookWebHook(t *testing.T) {
 	}
 	assert.Equal(t, parsedEvent1, parsedEvent2)
 }
+
+func TestParseWebhookUnknownEventType(t *testing.T) {
+	raw := []byte(`{"object_kind":"member"}`)
+
+	parsedEvent, err := ParseWebhook("Member Hook", raw)
+	if parsedEvent != nil {
+		t.Errorf("Expected no event, but parsing produced %T", parsedEvent)
+	}
+
+	var unknown *UnknownEventTypeError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Expected UnknownEventTypeError, got %v", err)
+	}
+	assert.Equal(t, EventType("Member Hook"), unknown.EventType)
+	assert.Equal(t, raw, unknown.Payload)
+}
+
+func TestParseConfidentialIssueHookChanges(t *testing.T) {
+	raw := []byte(`{
+		"object_kind": "issue",
+		"object_attributes": {"id": 301, "iid": 23, "confidential": true, "state": "closed", "action": "close"},
+		"changes": {
+			"state_id": {"previous": 1, "current": 2},
+			"title": {"previous": "Old title", "current": "New title"},
+			"assignees": {"previous": [], "current": [{"name": "User1", "username": "user1"}]}
+		}
+	}`)
+
+	parsedEvent, err := ParseWebhook("Confidential Issue Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing confidential issue hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*IssueEvent)
+	if !ok {
+		t.Fatalf("Expected IssueEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.True(t, event.ObjectAttributes.Confidential)
+	assert.Equal(t, 1, event.Changes.StateID.Previous)
+	assert.Equal(t, 2, event.Changes.StateID.Current)
+	assert.Equal(t, "Old title", event.Changes.Title.Previous)
+	assert.Equal(t, "New title", event.Changes.Title.Current)
+	assert.Equal(t, []MergeAssignee{}, event.Changes.Assignees.Previous)
+	assert.Equal(t, []MergeAssignee{{Name: "User1", Username: "user1"}}, event.Changes.Assignees.Current)
+}
+
+func TestParseWebhookRequest(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/push.json")
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "s3cret")
+
+	parsedEvent, err := ParseWebhookRequest(req, "s3cret", 0)
+	if err != nil {
+		t.Fatalf("Error parsing webhook request: %s", err)
+	}
+
+	if _, ok := parsedEvent.(*PushEvent); !ok {
+		t.Errorf("Expected PushEvent, but parsing produced %T", parsedEvent)
+	}
+}
+
+func TestParseWebhookRequestInvalidToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Fatalf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "wrong")
+
+	_, err = ParseWebhookRequest(req, "s3cret", 0)
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func TestParseWebhookRequestPayloadTooLarge(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/push.json")
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "s3cret")
+
+	_, err = ParseWebhookRequest(req, "s3cret", int64(len(raw)-1))
+	assert.Equal(t, ErrWebhookPayloadTooLarge, err)
+}
+
+func TestParseWebhookRequestEmptySecret(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Fatalf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+
+	_, err = ParseWebhookRequest(req, "", 0)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	req.Header.Set("X-Gitlab-Token", "forged")
+	_, err = ParseWebhookRequest(req, "", 0)
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func TestParseUnverifiedWebhookRequest(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Fatalf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+
+	parsedEvent, err := ParseUnverifiedWebhookRequest(req, 0)
+	if err != nil {
+		t.Fatalf("Error parsing webhook request: %s", err)
+	}
+
+	if _, ok := parsedEvent.(*PushEvent); !ok {
+		t.Errorf("Expected PushEvent, but parsing produced %T", parsedEvent)
+	}
+}
+
+func TestParseReleaseHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/release.json")
+
+	parsedEvent, err := ParseWebhook("Release Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing release hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*ReleaseEvent)
+	if !ok {
+		t.Fatalf("Expected ReleaseEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "release", event.ObjectKind)
+	assert.Equal(t, "create", event.Action)
+	assert.Equal(t, "v1.1", event.Tag)
+	assert.Equal(t, 5, event.Assets.Count)
+	assert.Equal(t, "Changelog", event.Assets.Links[0].Name)
+	assert.Equal(t, "zip", event.Assets.Sources[0].Format)
+	assert.Equal(t, "ee0a3fb31ac16e11b9dbb596ad16d4af654d08f8", event.Commit.ID)
+}
+
+func TestParseFeatureFlagHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/feature_flag.json")
+
+	parsedEvent, err := ParseWebhook("Feature Flag Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing feature flag hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*FeatureFlagEvent)
+	if !ok {
+		t.Fatalf("Expected FeatureFlagEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "feature_flag", event.ObjectKind)
+	assert.Equal(t, 1, event.Project.ID)
+	assert.Equal(t, "root", event.User.Username)
+	assert.Equal(t, "test-feature-flag", event.ObjectAttributes.Name)
+	assert.True(t, event.ObjectAttributes.Active)
+}
+
+type testMemberEvent struct {
+	ObjectKind string `json:"object_kind"`
+	UserID     int    `json:"user_id"`
+}
+
+func TestRegisterEventType(t *testing.T) {
+	eventType := EventType("Test Member Hook")
+	err := RegisterEventType(eventType, func() interface{} { return &testMemberEvent{} })
+	if err != nil {
+		t.Fatalf("Error registering event type: %s", err)
+	}
+	defer func() {
+		webhookEventsMu.Lock()
+		delete(webhookEvents, eventType)
+		webhookEventsMu.Unlock()
+	}()
+
+	assert.Contains(t, WebhookEventTypes(), eventType)
+	assert.Contains(t, WebhookEvents(), eventType)
+
+	parsedEvent, err := ParseWebhook(eventType, []byte(`{"object_kind":"member","user_id":3}`))
+	if err != nil {
+		t.Fatalf("Error parsing registered hook: %s", err)
+	}
+	assert.Equal(t, &testMemberEvent{ObjectKind: "member", UserID: 3}, parsedEvent)
+}
+
+func TestRegisterEventTypeOverridesBuiltin(t *testing.T) {
+	builtin := WebhookEvents()[EventTypePush]
+	defer func() {
+		webhookEventsMu.Lock()
+		webhookEvents[EventTypePush] = builtin
+		webhookEventsMu.Unlock()
+	}()
+
+	err := RegisterEventType(EventTypePush, func() interface{} { return &testMemberEvent{} })
+	if err != nil {
+		t.Fatalf("Error registering event type: %s", err)
+	}
+
+	parsedEvent, err := ParseWebhook(EventTypePush, []byte(`{"object_kind":"push","user_id":3}`))
+	if err != nil {
+		t.Fatalf("Error parsing overridden hook: %s", err)
+	}
+	assert.Equal(t, &testMemberEvent{ObjectKind: "push", UserID: 3}, parsedEvent)
+}
+
+func TestRegisterEventTypeInvalid(t *testing.T) {
+	factory := func() interface{} { return &testMemberEvent{} }
+
+	assert.Error(t, RegisterEventType(EventType("Test Member Hook"), nil))
+	assert.Error(t, RegisterEventType(EventTypeSystemHook, factory))
+	assert.Error(t, RegisterEventType(EventTypeNote, factory))
+	assert.Error(t, RegisterEventType(EventConfidentialNote, factory))
+	assert.NotContains(t, WebhookEvents(), EventTypeSystemHook)
+}
+
+func TestWebhookEventsReturnsCopy(t *testing.T) {
+	events := WebhookEvents()
+	delete(events, EventTypePush)
+
+	assert.Contains(t, WebhookEvents(), EventTypePush)
+}
+
+func TestWebhookEventTypes(t *testing.T) {
+	types := WebhookEventTypes()
+	assert.Contains(t, types, EventTypePush)
+	assert.Contains(t, types, EventTypeNote)
+	assert.Contains(t, types, EventTypeRelease)
+	assert.NotContains(t, types, EventTypeSystemHook)
+}
+
+func TestParseIssueHookUntypedChanges(t *testing.T) {
+	raw := []byte(`{
+		"object_kind": "issue",
+		"changes": {
+			"title": {"previous": "Old", "current": "New"},
+			"due_date": {"previous": null, "current": "2021-06-01"},
+			"weight": {"previous": 1, "current": 3}
+		}
+	}`)
+
+	parsedEvent, err := ParseWebhook("Issue Hook", raw)
+	if err != nil {
+		t.Fatalf("Error parsing issue hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*IssueEvent)
+	if !ok {
+		t.Fatalf("Expected IssueEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, "New", event.Changes.Title.Current)
+	assert.NotContains(t, event.Changes.Raw, "title")
+	assert.JSONEq(t, `{"previous": null, "current": "2021-06-01"}`, string(event.Changes.Raw["due_date"]))
+	assert.JSONEq(t, `{"previous": 1, "current": 3}`, string(event.Changes.Raw["weight"]))
+}
+
+func TestParseMergeRequestHookUntypedChanges(t *testing.T) {
+	raw := []byte(`{
+		"object_kind": "merge_request",
+		"changes": {
+			"draft": {"previous": true, "current": false},
+			"state_id": {"previous": 1, "current": 3}
+		}
+	}`)
+
+	parsedEvent, err := ParseWebhook("Merge Request Hook", raw)
+	if err != nil {
+		t.Fatalf("Error parsing merge request hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*MergeEvent)
+	if !ok {
+		t.Fatalf("Expected MergeEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Equal(t, 3, event.Changes.StateID.Current)
+	assert.Len(t, event.Changes.Raw, 1)
+	assert.JSONEq(t, `{"previous": true, "current": false}`, string(event.Changes.Raw["draft"]))
+}
+
+func TestParseMergeRequestHookFixtureUntypedChanges(t *testing.T) {
+	parsedEvent, err := ParseWebhook("Merge Request Hook", loadFixture("testdata/webhooks/merge_request.json"))
+	if err != nil {
+		t.Fatalf("Error parsing merge request hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*MergeEvent)
+	if !ok {
+		t.Fatalf("Expected MergeEvent, but parsing produced %T", parsedEvent)
+	}
+
+	assert.Contains(t, event.Changes.Raw, "updated_at")
+	assert.NotContains(t, event.Changes.Raw, "labels")
+	assert.NotContains(t, event.Changes.Raw, "updated_by_id")
+}
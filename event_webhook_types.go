@@ -19,7 +19,9 @@ package gitlab
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -40,6 +42,7 @@ type PushEvent struct {
 	UserAvatar   string `json:"user_avatar"`
 	ProjectID    int    `json:"project_id"`
 	Project      struct {
+		ID                int             `json:"id"`
 		Name              string          `json:"name"`
 		Description       string          `json:"description"`
 		AvatarURL         string          `json:"avatar_url"`
@@ -59,6 +62,7 @@ type PushEvent struct {
 	Commits    []*struct {
 		ID        string     `json:"id"`
 		Message   string     `json:"message"`
+		Title     string     `json:"title"`
 		Timestamp *time.Time `json:"timestamp"`
 		URL       string     `json:"url"`
 		Author    struct {
@@ -72,23 +76,26 @@ type PushEvent struct {
 	TotalCommitsCount int `json:"total_commits_count"`
 }
 
-// TagEvent represents a tag event.
+// TagEvent represents a tag push event, sent with the Tag Push Hook event
+// type.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#tag-events
 type TagEvent struct {
-	ObjectKind  string `json:"object_kind"`
-	Before      string `json:"before"`
-	After       string `json:"after"`
-	Ref         string `json:"ref"`
-	CheckoutSHA string `json:"checkout_sha"`
-	UserID      int    `json:"user_id"`
-	UserName    string `json:"user_name"`
-	UserAvatar  string `json:"user_avatar"`
-	UserEmail   string `json:"user_email"`
-	ProjectID   int    `json:"project_id"`
-	Message     string `json:"message"`
-	Project     struct {
+	ObjectKind   string `json:"object_kind"`
+	Before       string `json:"before"`
+	After        string `json:"after"`
+	Ref          string `json:"ref"`
+	CheckoutSHA  string `json:"checkout_sha"`
+	UserID       int    `json:"user_id"`
+	UserName     string `json:"user_name"`
+	UserUsername string `json:"user_username"`
+	UserAvatar   string `json:"user_avatar"`
+	UserEmail    string `json:"user_email"`
+	ProjectID    int    `json:"project_id"`
+	Message      string `json:"message"`
+	Project      struct {
+		ID                int             `json:"id"`
 		Name              string          `json:"name"`
 		Description       string          `json:"description"`
 		AvatarURL         string          `json:"avatar_url"`
@@ -108,6 +115,7 @@ type TagEvent struct {
 	Commits    []*struct {
 		ID        string     `json:"id"`
 		Message   string     `json:"message"`
+		Title     string     `json:"title"`
 		Timestamp *time.Time `json:"timestamp"`
 		URL       string     `json:"url"`
 		Author    struct {
@@ -121,7 +129,8 @@ type TagEvent struct {
 	TotalCommitsCount int `json:"total_commits_count"`
 }
 
-// IssueEvent represents a issue event.
+// IssueEvent represents a issue event. Confidential issues are delivered as
+// the same event, with the Confidential Issue Hook event type.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#issues-events
@@ -147,21 +156,22 @@ type IssueEvent struct {
 	} `json:"project"`
 	Repository       *Repository `json:"repository"`
 	ObjectAttributes struct {
-		ID          int    `json:"id"`
-		Title       string `json:"title"`
-		AssigneeID  int    `json:"assignee_id"`
-		AuthorID    int    `json:"author_id"`
-		ProjectID   int    `json:"project_id"`
-		CreatedAt   string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
-		UpdatedAt   string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
-		Position    int    `json:"position"`
-		BranchName  string `json:"branch_name"`
-		Description string `json:"description"`
-		MilestoneID int    `json:"milestone_id"`
-		State       string `json:"state"`
-		IID         int    `json:"iid"`
-		URL         string `json:"url"`
-		Action      string `json:"action"`
+		ID           int    `json:"id"`
+		Title        string `json:"title"`
+		AssigneeID   int    `json:"assignee_id"`
+		AuthorID     int    `json:"author_id"`
+		ProjectID    int    `json:"project_id"`
+		CreatedAt    string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+		UpdatedAt    string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
+		Position     int    `json:"position"`
+		BranchName   string `json:"branch_name"`
+		Description  string `json:"description"`
+		MilestoneID  int    `json:"milestone_id"`
+		State        string `json:"state"`
+		IID          int    `json:"iid"`
+		URL          string `json:"url"`
+		Action       string `json:"action"`
+		Confidential bool   `json:"confidential"`
 	} `json:"object_attributes"`
 	Assignee struct {
 		Name      string `json:"name"`
@@ -173,17 +183,146 @@ type IssueEvent struct {
 		Username  string `json:"username"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"assignees"`
-	Labels  []Label `json:"labels"`
-	Changes struct {
-		Labels struct {
-			Previous []Label `json:"previous"`
-			Current  []Label `json:"current"`
-		} `json:"labels"`
-		UpdatedByID struct {
-			Previous int `json:"previous"`
-			Current  int `json:"current"`
-		} `json:"updated_by_id"`
-	} `json:"changes"`
+	Labels  []Label           `json:"labels"`
+	Changes IssueEventChanges `json:"changes"`
+}
+
+// IssueEventChanges represents the attributes changed by an issue event.
+// Changed attributes without a field are available in Raw.
+type IssueEventChanges struct {
+	Assignees struct {
+		Previous []MergeAssignee `json:"previous"`
+		Current  []MergeAssignee `json:"current"`
+	} `json:"assignees"`
+	Confidential struct {
+		Previous bool `json:"previous"`
+		Current  bool `json:"current"`
+	} `json:"confidential"`
+	Description struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"description"`
+	Labels struct {
+		Previous []Label `json:"previous"`
+		Current  []Label `json:"current"`
+	} `json:"labels"`
+	StateID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"state_id"`
+	Title struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"title"`
+	UpdatedByID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"updated_by_id"`
+
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *IssueEventChanges) UnmarshalJSON(data []byte) error {
+	type alias IssueEventChanges
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+
+	raw, err := untypedChanges(data, c)
+	if err != nil {
+		return err
+	}
+	c.Raw = raw
+
+	return nil
+}
+
+// DeploymentEvent represents a deployment event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#deployment-events
+type DeploymentEvent struct {
+	ObjectKind             string `json:"object_kind"`
+	Status                 string `json:"status"`
+	StatusChangedAt        string `json:"status_changed_at"`
+	DeploymentID           int    `json:"deployment_id"`
+	DeployableID           int    `json:"deployable_id"`
+	DeployableURL          string `json:"deployable_url"`
+	Environment            string `json:"environment"`
+	EnvironmentTier        string `json:"environment_tier"`
+	EnvironmentSlug        string `json:"environment_slug"`
+	EnvironmentExternalURL string `json:"environment_external_url"`
+	Project                struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		Description       string `json:"description"`
+		WebURL            string `json:"web_url"`
+		AvatarURL         string `json:"avatar_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		GitHTTPURL        string `json:"git_http_url"`
+		Namespace         string `json:"namespace"`
+		VisibilityLevel   int    `json:"visibility_level"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+		CIConfigPath      string `json:"ci_config_path"`
+		Homepage          string `json:"homepage"`
+		URL               string `json:"url"`
+		SSHURL            string `json:"ssh_url"`
+		HTTPURL           string `json:"http_url"`
+	} `json:"project"`
+	Ref      string `json:"ref"`
+	ShortSHA string `json:"short_sha"`
+	User     struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	UserURL     string `json:"user_url"`
+	CommitURL   string `json:"commit_url"`
+	CommitTitle string `json:"commit_title"`
+}
+
+// FeatureFlagEvent represents a feature flag event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#feature-flag-events
+type FeatureFlagEvent struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		Description       string `json:"description"`
+		WebURL            string `json:"web_url"`
+		AvatarURL         string `json:"avatar_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		GitHTTPURL        string `json:"git_http_url"`
+		Namespace         string `json:"namespace"`
+		VisibilityLevel   int    `json:"visibility_level"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+		CIConfigPath      string `json:"ci_config_path"`
+		Homepage          string `json:"homepage"`
+		URL               string `json:"url"`
+		SSHURL            string `json:"ssh_url"`
+		HTTPURL           string `json:"http_url"`
+	} `json:"project"`
+	User struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	UserURL          string `json:"user_url"`
+	ObjectAttributes struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Active      bool   `json:"active"`
+	} `json:"object_attributes"`
 }
 
 // JobEvent represents a job event.
@@ -423,6 +562,8 @@ type IssueCommentEvent struct {
 		System       bool    `json:"system"`
 		StDiff       []*Diff `json:"st_diff"`
 		URL          string  `json:"url"`
+		Confidential bool    `json:"confidential"`
+		Internal     bool    `json:"internal"`
 	} `json:"object_attributes"`
 	Issue struct {
 		ID                  int      `json:"id"`
@@ -570,51 +711,73 @@ type MergeEvent struct {
 		OldRev         string        `json:"oldrev"`
 		Assignee       MergeAssignee `json:"assignee"`
 	} `json:"object_attributes"`
-	Repository *Repository   `json:"repository"`
-	Assignee   MergeAssignee `json:"assignee"`
-	Labels     []Label       `json:"labels"`
-	Changes    struct {
-		Assignees struct {
-			Previous []MergeAssignee `json:"previous"`
-			Current  []MergeAssignee `json:"current"`
-		} `json:"assignees"`
-		Description struct {
-			Previous string `json:"previous"`
-			Current  string `json:"current"`
-		} `json:"description"`
-		Labels struct {
-			Previous []Label `json:"previous"`
-			Current  []Label `json:"current"`
-		} `json:"labels"`
-		SourceBranch struct {
-			Previous string `json:"previous"`
-			Current  string `json:"current"`
-		} `json:"source_branch"`
-		SourceProjectID struct {
-			Previous int `json:"previous"`
-			Current  int `json:"current"`
-		} `json:"source_project_id"`
-		StateID struct {
-			Previous int `json:"previous"`
-			Current  int `json:"current"`
-		} `json:"state_id"`
-		TargetBranch struct {
-			Previous string `json:"previous"`
-			Current  string `json:"current"`
-		} `json:"target_branch"`
-		TargetProjectID struct {
-			Previous int `json:"previous"`
-			Current  int `json:"current"`
-		} `json:"target_project_id"`
-		Title struct {
-			Previous string `json:"previous"`
-			Current  string `json:"current"`
-		} `json:"title"`
-		UpdatedByID struct {
-			Previous int `json:"previous"`
-			Current  int `json:"current"`
-		} `json:"updated_by_id"`
-	} `json:"changes"`
+	Repository *Repository       `json:"repository"`
+	Assignee   MergeAssignee     `json:"assignee"`
+	Labels     []Label           `json:"labels"`
+	Changes    MergeEventChanges `json:"changes"`
+}
+
+// MergeEventChanges represents the attributes changed by a merge request
+// event. Changed attributes without a field are available in Raw.
+type MergeEventChanges struct {
+	Assignees struct {
+		Previous []MergeAssignee `json:"previous"`
+		Current  []MergeAssignee `json:"current"`
+	} `json:"assignees"`
+	Description struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"description"`
+	Labels struct {
+		Previous []Label `json:"previous"`
+		Current  []Label `json:"current"`
+	} `json:"labels"`
+	SourceBranch struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"source_branch"`
+	SourceProjectID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"source_project_id"`
+	StateID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"state_id"`
+	TargetBranch struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"target_branch"`
+	TargetProjectID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"target_project_id"`
+	Title struct {
+		Previous string `json:"previous"`
+		Current  string `json:"current"`
+	} `json:"title"`
+	UpdatedByID struct {
+		Previous int `json:"previous"`
+		Current  int `json:"current"`
+	} `json:"updated_by_id"`
+
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *MergeEventChanges) UnmarshalJSON(data []byte) error {
+	type alias MergeEventChanges
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+
+	raw, err := untypedChanges(data, c)
+	if err != nil {
+		return err
+	}
+	c.Raw = raw
+
+	return nil
 }
 
 // MergeAssignee represents a merge assignee.
@@ -663,6 +826,65 @@ func (p *MergeParams) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// ReleaseEvent represents a release event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#release-events
+type ReleaseEvent struct {
+	ID          int    `json:"id"`
+	CreatedAt   string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	Tag         string `json:"tag"`
+	ReleasedAt  string `json:"released_at"` // Should be *time.Time (see Gitlab issue #21468)
+	ObjectKind  string `json:"object_kind"`
+	Project     struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		Description       string `json:"description"`
+		WebURL            string `json:"web_url"`
+		AvatarURL         string `json:"avatar_url"`
+		GitSSHURL         string `json:"git_ssh_url"`
+		GitHTTPURL        string `json:"git_http_url"`
+		Namespace         string `json:"namespace"`
+		VisibilityLevel   int    `json:"visibility_level"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+		CIConfigPath      string `json:"ci_config_path"`
+		Homepage          string `json:"homepage"`
+		URL               string `json:"url"`
+		SSHURL            string `json:"ssh_url"`
+		HTTPURL           string `json:"http_url"`
+	} `json:"project"`
+	URL    string `json:"url"`
+	Action string `json:"action"`
+	Assets struct {
+		Count int `json:"count"`
+		Links []struct {
+			ID       int    `json:"id"`
+			External bool   `json:"external"`
+			LinkType string `json:"link_type"`
+			Name     string `json:"name"`
+			URL      string `json:"url"`
+		} `json:"links"`
+		Sources []struct {
+			Format string `json:"format"`
+			URL    string `json:"url"`
+		} `json:"sources"`
+	} `json:"assets"`
+	Commit struct {
+		ID        string     `json:"id"`
+		Message   string     `json:"message"`
+		Title     string     `json:"title"`
+		Timestamp *time.Time `json:"timestamp"`
+		URL       string     `json:"url"`
+		Author    struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
 // WikiPageEvent represents a wiki page event.
 //
 // GitLab API docs:
@@ -721,6 +943,10 @@ type PipelineEvent struct {
 		CreatedAt  string   `json:"created_at"`
 		FinishedAt string   `json:"finished_at"`
 		Duration   int      `json:"duration"`
+		Variables  []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"variables"`
 	} `json:"object_attributes"`
 	MergeRequest struct {
 		ID                 int    `json:"id"`
@@ -832,3 +1058,24 @@ type BuildEvent struct {
 	} `json:"commit"`
 	Repository *Repository `json:"repository"`
 }
+
+// untypedChanges returns the changed attributes in data that have no field
+// in v, which must be a pointer to a struct.
+func untypedChanges(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		delete(raw, name)
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	return raw, nil
+}
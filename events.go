@@ -34,6 +34,7 @@ type EventsService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/events.html#get-user-contribution-events
 type ContributionEvent struct {
+	ID          int        `json:"id"`
 	Title       string     `json:"title"`
 	ProjectID   int        `json:"project_id"`
 	ActionName  string     `json:"action_name"`
@@ -64,7 +65,8 @@ type ContributionEvent struct {
 	AuthorUsername string `json:"author_username"`
 }
 
-// ListContributionEventsOptions represents the options for GetUserContributionEvents
+// ListContributionEventsOptions represents the options for GetUserContributionEvents.
+// Before and After are dates (YYYY-MM-DD) and are both exclusive.
 //
 // GitLap API docs:
 // https://docs.gitlab.com/ce/api/events.html#get-user-contribution-events
@@ -87,7 +89,7 @@ func (s *UsersService) ListUserContributionEvents(uid interface{}, opt *ListCont
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/events", user)
+	u := fmt.Sprintf("users/%s/events", pathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListUserContributionEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/jdoe/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/jdoe/events?action=pushed&after=2021-01-01&before=2021-02-01&sort=asc&target_type=issue")
+		fmt.Fprint(w, `[{
+			"id": 3,
+			"title": null,
+			"project_id": 15,
+			"action_name": "pushed to",
+			"target_id": null,
+			"target_type": null,
+			"author_id": 1,
+			"author": {"name": "Jane Doe", "username": "jdoe", "id": 1, "state": "active"},
+			"author_username": "jdoe",
+			"created_at": "2021-01-15T10:00:00Z",
+			"push_data": {
+				"commit_count": 1,
+				"action": "pushed",
+				"ref_type": "branch",
+				"commit_from": "50d4420237a9de7be1304607147aec22e4a14af7",
+				"commit_to": "c5feabde2d8cd023215af4d2ceeb7a64839fc428",
+				"ref": "master",
+				"commit_title": "Add simple search to projects in public area"
+			}
+		}]`)
+	})
+
+	action := PushedEventType
+	targetType := IssueEventTargetType
+	after := ISOTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	before := ISOTime(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC))
+	opt := &ListContributionEventsOptions{
+		Action:     &action,
+		TargetType: &targetType,
+		After:      &after,
+		Before:     &before,
+		Sort:       String("asc"),
+	}
+	events, _, err := client.Users.ListUserContributionEvents("jdoe", opt)
+	if err != nil {
+		t.Fatalf("Users.ListUserContributionEvents returned error: %v", err)
+	}
+
+	createdAt := time.Date(2021, 1, 15, 10, 0, 0, 0, time.UTC)
+	want := &ContributionEvent{
+		ID:             3,
+		ProjectID:      15,
+		ActionName:     "pushed to",
+		AuthorID:       1,
+		AuthorUsername: "jdoe",
+		CreatedAt:      &createdAt,
+	}
+	want.Author.Name = "Jane Doe"
+	want.Author.Username = "jdoe"
+	want.Author.ID = 1
+	want.Author.State = "active"
+	want.PushData.CommitCount = 1
+	want.PushData.Action = "pushed"
+	want.PushData.RefType = "branch"
+	want.PushData.CommitFrom = "50d4420237a9de7be1304607147aec22e4a14af7"
+	want.PushData.CommitTo = "c5feabde2d8cd023215af4d2ceeb7a64839fc428"
+	want.PushData.Ref = "master"
+	want.PushData.CommitTitle = "Add simple search to projects in public area"
+
+	if !reflect.DeepEqual([]*ContributionEvent{want}, events) {
+		t.Errorf("Users.ListUserContributionEvents returned %+v, want %+v", events, want)
+	}
+}
+
+func TestListCurrentUserContributionEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/events?page=2&per_page=50")
+		fmt.Fprint(w, `[{"id":1,"project_id":2,"action_name":"opened","target_id":160,"target_iid":53,"target_type":"Issue","target_title":"Broken link"}]`)
+	})
+
+	opt := &ListContributionEventsOptions{ListOptions: ListOptions{Page: 2, PerPage: 50}}
+	events, _, err := client.Events.ListCurrentUserContributionEvents(opt)
+	if err != nil {
+		t.Fatalf("Events.ListCurrentUserContributionEvents returned error: %v", err)
+	}
+
+	want := []*ContributionEvent{{
+		ID:          1,
+		ProjectID:   2,
+		ActionName:  "opened",
+		TargetID:    160,
+		TargetIID:   53,
+		TargetType:  "Issue",
+		TargetTitle: "Broken link",
+	}}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Events.ListCurrentUserContributionEvents returned %+v, want %+v", events, want)
+	}
+}
+
+func TestListProjectVisibleEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/2/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/2/events?target_type=note")
+		fmt.Fprint(w, `[{"id":4,"project_id":2,"action_name":"commented on","target_type":"Note","note":{"id":1312,"body":"What an awesome day!","noteable_type":"Issue"}}]`)
+	})
+
+	targetType := NoteEventTargetType
+	events, _, err := client.Events.ListProjectVisibleEvents(2, &ListContributionEventsOptions{TargetType: &targetType})
+	if err != nil {
+		t.Fatalf("Events.ListProjectVisibleEvents returned error: %v", err)
+	}
+
+	want := []*ContributionEvent{{
+		ID:         4,
+		ProjectID:  2,
+		ActionName: "commented on",
+		TargetType: "Note",
+		Note:       &Note{ID: 1312, Body: "What an awesome day!", NoteableType: "Issue"},
+	}}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Events.ListProjectVisibleEvents returned %+v, want %+v", events, want)
+	}
+}
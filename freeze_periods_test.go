@@ -0,0 +1,151 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListFreezePeriods(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"freeze_start":"0 23 * * 5","freeze_end":"0 8 * * 1","cron_timezone":"UTC","created_at":"2020-05-15T17:03:35.702Z","updated_at":"2020-05-15T17:06:41.566Z"}]`)
+	})
+
+	periods, _, err := client.FreezePeriods.ListFreezePeriods(19, nil)
+	if err != nil {
+		t.Fatalf("FreezePeriods.ListFreezePeriods returned error: %v", err)
+	}
+
+	createdAt := time.Date(2020, 5, 15, 17, 3, 35, 702000000, time.UTC)
+	updatedAt := time.Date(2020, 5, 15, 17, 6, 41, 566000000, time.UTC)
+	want := []*FreezePeriod{{
+		ID:           1,
+		FreezeStart:  "0 23 * * 5",
+		FreezeEnd:    "0 8 * * 1",
+		CronTimezone: "UTC",
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+	}}
+	if !reflect.DeepEqual(want, periods) {
+		t.Errorf("FreezePeriods.ListFreezePeriods returned %+v, want %+v", periods, want)
+	}
+}
+
+func TestGetFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"freeze_start":"0 23 * * 5","freeze_end":"0 8 * * 1","cron_timezone":"UTC"}`)
+	})
+
+	period, _, err := client.FreezePeriods.GetFreezePeriod(19, 1)
+	if err != nil {
+		t.Fatalf("FreezePeriods.GetFreezePeriod returned error: %v", err)
+	}
+
+	want := &FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 8 * * 1", CronTimezone: "UTC"}
+	if !reflect.DeepEqual(want, period) {
+		t.Errorf("FreezePeriods.GetFreezePeriod returned %+v, want %+v", period, want)
+	}
+}
+
+func TestCreateFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"freeze_start":"0 0 25 3,6,9,12 *","freeze_end":"0 0 1 1,4,7,10 *","cron_timezone":"Europe/Amsterdam"}`)
+		fmt.Fprint(w, `{"id":2,"freeze_start":"0 0 25 3,6,9,12 *","freeze_end":"0 0 1 1,4,7,10 *","cron_timezone":"Europe/Amsterdam"}`)
+	})
+
+	opt := &CreateFreezePeriodOptions{
+		FreezeStart:  String("0 0 25 3,6,9,12 *"),
+		FreezeEnd:    String("0 0 1 1,4,7,10 *"),
+		CronTimezone: String("Europe/Amsterdam"),
+	}
+
+	period, _, err := client.FreezePeriods.CreateFreezePeriodOptions(19, opt)
+	if err != nil {
+		t.Fatalf("FreezePeriods.CreateFreezePeriodOptions returned error: %v", err)
+	}
+
+	want := &FreezePeriod{ID: 2, FreezeStart: "0 0 25 3,6,9,12 *", FreezeEnd: "0 0 1 1,4,7,10 *", CronTimezone: "Europe/Amsterdam"}
+	if !reflect.DeepEqual(want, period) {
+		t.Errorf("FreezePeriods.CreateFreezePeriodOptions returned %+v, want %+v", period, want)
+	}
+}
+
+func TestUpdateFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"freeze_end":"0 8 * * 2"}`)
+		fmt.Fprint(w, `{"id":1,"freeze_start":"0 23 * * 5","freeze_end":"0 8 * * 2","cron_timezone":"UTC"}`)
+	})
+
+	period, _, err := client.FreezePeriods.UpdateFreezePeriod(19, 1, &UpdateFreezePeriodOptions{FreezeEnd: String("0 8 * * 2")})
+	if err != nil {
+		t.Fatalf("FreezePeriods.UpdateFreezePeriod returned error: %v", err)
+	}
+
+	want := &FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 8 * * 2", CronTimezone: "UTC"}
+	if !reflect.DeepEqual(want, period) {
+		t.Errorf("FreezePeriods.UpdateFreezePeriod returned %+v, want %+v", period, want)
+	}
+}
+
+func TestDeleteFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.FreezePeriods.DeleteFreezePeriod(19, 1)
+	if err != nil {
+		t.Fatalf("FreezePeriods.DeleteFreezePeriod returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("FreezePeriods.DeleteFreezePeriod returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestFreezePeriodRoundTrip(t *testing.T) {
+	createdAt := time.Date(2020, 5, 15, 17, 3, 35, 0, time.UTC)
+	period := &FreezePeriod{
+		ID:           3,
+		FreezeStart:  "0 0 25 3,6,9,12 *",
+		FreezeEnd:    "0 0 1 1,4,7,10 *",
+		CronTimezone: "America/New_York",
+		CreatedAt:    &createdAt,
+	}
+
+	b, err := json.Marshal(period)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	got := new(FreezePeriod)
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(period, got) {
+		t.Errorf("FreezePeriod round-trip returned %+v, want %+v", got, period)
+	}
+}
@@ -0,0 +1,333 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// GeoNodesService handles communication with Geo Nodes related methods
+// of GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNodesService struct {
+	client *Client
+}
+
+// GeoNode represents a GitLab Geo Node.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNode struct {
+	ID                               int          `json:"id"`
+	Name                             string       `json:"name"`
+	URL                              string       `json:"url"`
+	InternalURL                      string       `json:"internal_url"`
+	Primary                          bool         `json:"primary"`
+	Enabled                          bool         `json:"enabled"`
+	Current                          bool         `json:"current"`
+	FilesMaxCapacity                 int          `json:"files_max_capacity"`
+	ReposMaxCapacity                 int          `json:"repos_max_capacity"`
+	VerificationMaxCapacity          int          `json:"verification_max_capacity"`
+	ContainerRepositoriesMaxCapacity int          `json:"container_repositories_max_capacity"`
+	SelectiveSyncType                string       `json:"selective_sync_type"`
+	SelectiveSyncShards              []string     `json:"selective_sync_shards"`
+	SelectiveSyncNamespaceIDs        []int        `json:"selective_sync_namespace_ids"`
+	MinimumReverificationInterval    int          `json:"minimum_reverification_interval"`
+	SyncObjectStorage                bool         `json:"sync_object_storage"`
+	CloneProtocol                    string       `json:"clone_protocol"`
+	WebEditURL                       string       `json:"web_edit_url"`
+	WebGeoProjectsURL                string       `json:"web_geo_projects_url"`
+	Links                            GeoNodeLinks `json:"_links"`
+}
+
+// GeoNodeLinks represents links for GitLab GeoNode.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNodeLinks struct {
+	Self   string `json:"self"`
+	Status string `json:"status"`
+	Repair string `json:"repair"`
+}
+
+// CreateGeoNodesOptions represents the available CreateGeoNode() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#create-a-new-geo-node
+type CreateGeoNodesOptions struct {
+	Primary                          *bool     `url:"primary,omitempty" json:"primary,omitempty"`
+	Enabled                          *bool     `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Name                             *string   `url:"name,omitempty" json:"name,omitempty"`
+	URL                              *string   `url:"url,omitempty" json:"url,omitempty"`
+	InternalURL                      *string   `url:"internal_url,omitempty" json:"internal_url,omitempty"`
+	FilesMaxCapacity                 *int      `url:"files_max_capacity,omitempty" json:"files_max_capacity,omitempty"`
+	ReposMaxCapacity                 *int      `url:"repos_max_capacity,omitempty" json:"repos_max_capacity,omitempty"`
+	VerificationMaxCapacity          *int      `url:"verification_max_capacity,omitempty" json:"verification_max_capacity,omitempty"`
+	ContainerRepositoriesMaxCapacity *int      `url:"container_repositories_max_capacity,omitempty" json:"container_repositories_max_capacity,omitempty"`
+	SyncObjectStorage                *bool     `url:"sync_object_storage,omitempty" json:"sync_object_storage,omitempty"`
+	SelectiveSyncType                *string   `url:"selective_sync_type,omitempty" json:"selective_sync_type,omitempty"`
+	SelectiveSyncShards              *[]string `url:"selective_sync_shards,omitempty" json:"selective_sync_shards,omitempty"`
+	SelectiveSyncNamespaceIDs        *[]int    `url:"selective_sync_namespace_ids,omitempty" json:"selective_sync_namespace_ids,omitempty"`
+	MinimumReverificationInterval    *int      `url:"minimum_reverification_interval,omitempty" json:"minimum_reverification_interval,omitempty"`
+}
+
+// CreateGeoNode creates a new Geo Node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#create-a-new-geo-node
+func (s *GeoNodesService) CreateGeoNode(opt *CreateGeoNodesOptions, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	req, err := s.client.NewRequest("POST", "geo_nodes", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(GeoNode)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// ListGeoNodesOptions represents the available ListGeoNodes() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-all-geo-nodes
+type ListGeoNodesOptions ListOptions
+
+// ListGeoNodes gets a list of geo nodes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-all-geo-nodes
+func (s *GeoNodesService) ListGeoNodes(opt *ListGeoNodesOptions, options ...RequestOptionFunc) ([]*GeoNode, *Response, error) {
+	req, err := s.client.NewRequest("GET", "geo_nodes", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gs []*GeoNode
+	resp, err := s.client.Do(req, &gs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gs, resp, err
+}
+
+// GetGeoNode gets a specific geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-a-specific-geo-node
+func (s *GeoNodesService) GetGeoNode(id int, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(GeoNode)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// UpdateGeoNodesOptions represents the available EditGeoNode() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#edit-a-geo-node
+type UpdateGeoNodesOptions struct {
+	Enabled                          *bool     `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Name                             *string   `url:"name,omitempty" json:"name,omitempty"`
+	URL                              *string   `url:"url,omitempty" json:"url,omitempty"`
+	InternalURL                      *string   `url:"internal_url,omitempty" json:"internal_url,omitempty"`
+	FilesMaxCapacity                 *int      `url:"files_max_capacity,omitempty" json:"files_max_capacity,omitempty"`
+	ReposMaxCapacity                 *int      `url:"repos_max_capacity,omitempty" json:"repos_max_capacity,omitempty"`
+	VerificationMaxCapacity          *int      `url:"verification_max_capacity,omitempty" json:"verification_max_capacity,omitempty"`
+	ContainerRepositoriesMaxCapacity *int      `url:"container_repositories_max_capacity,omitempty" json:"container_repositories_max_capacity,omitempty"`
+	SyncObjectStorage                *bool     `url:"sync_object_storage,omitempty" json:"sync_object_storage,omitempty"`
+	SelectiveSyncType                *string   `url:"selective_sync_type,omitempty" json:"selective_sync_type,omitempty"`
+	SelectiveSyncShards              *[]string `url:"selective_sync_shards,omitempty" json:"selective_sync_shards,omitempty"`
+	SelectiveSyncNamespaceIDs        *[]int    `url:"selective_sync_namespace_ids,omitempty" json:"selective_sync_namespace_ids,omitempty"`
+	MinimumReverificationInterval    *int      `url:"minimum_reverification_interval,omitempty" json:"minimum_reverification_interval,omitempty"`
+}
+
+// EditGeoNode updates settings of an existing Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#edit-a-geo-node
+func (s *GeoNodesService) EditGeoNode(id int, opt *UpdateGeoNodesOptions, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(GeoNode)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// DeleteGeoNode removes the Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#delete-a-geo-node
+func (s *GeoNodesService) DeleteGeoNode(id int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RepairGeoNode repairs the OAuth authentication of a Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#repair-a-geo-node
+func (s *GeoNodesService) RepairGeoNode(id int, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d/repair", id)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(GeoNode)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// GeoNodeStatus represents the status of a Geo node. The percentages are
+// returned as formatted strings, such as "50.00%".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-all-geo-nodes
+type GeoNodeStatus struct {
+	GeoNodeID                               int        `json:"geo_node_id"`
+	Healthy                                 bool       `json:"healthy"`
+	Health                                  string     `json:"health"`
+	HealthStatus                            string     `json:"health_status"`
+	MissingOauthApplication                 bool       `json:"missing_oauth_application"`
+	DBReplicationLagSeconds                 int        `json:"db_replication_lag_seconds"`
+	AttachmentsCount                        int        `json:"attachments_count"`
+	AttachmentsSyncedCount                  int        `json:"attachments_synced_count"`
+	AttachmentsFailedCount                  int        `json:"attachments_failed_count"`
+	AttachmentsSyncedMissingOnPrimaryCount  int        `json:"attachments_synced_missing_on_primary_count"`
+	AttachmentsSyncedInPercentage           string     `json:"attachments_synced_in_percentage"`
+	LfsObjectsCount                         int        `json:"lfs_objects_count"`
+	LfsObjectsSyncedCount                   int        `json:"lfs_objects_synced_count"`
+	LfsObjectsFailedCount                   int        `json:"lfs_objects_failed_count"`
+	LfsObjectsSyncedMissingOnPrimaryCount   int        `json:"lfs_objects_synced_missing_on_primary_count"`
+	LfsObjectsSyncedInPercentage            string     `json:"lfs_objects_synced_in_percentage"`
+	JobArtifactsCount                       int        `json:"job_artifacts_count"`
+	JobArtifactsSyncedCount                 int        `json:"job_artifacts_synced_count"`
+	JobArtifactsFailedCount                 int        `json:"job_artifacts_failed_count"`
+	JobArtifactsSyncedMissingOnPrimaryCount int        `json:"job_artifacts_synced_missing_on_primary_count"`
+	JobArtifactsSyncedInPercentage          string     `json:"job_artifacts_synced_in_percentage"`
+	ContainerRepositoriesCount              int        `json:"container_repositories_count"`
+	ContainerRepositoriesSyncedCount        int        `json:"container_repositories_synced_count"`
+	ContainerRepositoriesFailedCount        int        `json:"container_repositories_failed_count"`
+	ContainerRepositoriesSyncedInPercentage string     `json:"container_repositories_synced_in_percentage"`
+	DesignRepositoriesCount                 int        `json:"design_repositories_count"`
+	DesignRepositoriesSyncedCount           int        `json:"design_repositories_synced_count"`
+	DesignRepositoriesFailedCount           int        `json:"design_repositories_failed_count"`
+	DesignRepositoriesSyncedInPercentage    string     `json:"design_repositories_synced_in_percentage"`
+	ProjectsCount                           int        `json:"projects_count"`
+	RepositoriesCount                       int        `json:"repositories_count"`
+	RepositoriesFailedCount                 int        `json:"repositories_failed_count"`
+	RepositoriesSyncedCount                 int        `json:"repositories_synced_count"`
+	RepositoriesSyncedInPercentage          string     `json:"repositories_synced_in_percentage"`
+	WikisCount                              int        `json:"wikis_count"`
+	WikisFailedCount                        int        `json:"wikis_failed_count"`
+	WikisSyncedCount                        int        `json:"wikis_synced_count"`
+	WikisSyncedInPercentage                 string     `json:"wikis_synced_in_percentage"`
+	ReplicationSlotsCount                   int        `json:"replication_slots_count"`
+	ReplicationSlotsUsedCount               int        `json:"replication_slots_used_count"`
+	ReplicationSlotsUsedInPercentage        string     `json:"replication_slots_used_in_percentage"`
+	ReplicationSlotsMaxRetainedWalBytes     int64      `json:"replication_slots_max_retained_wal_bytes"`
+	RepositoriesCheckedCount                int        `json:"repositories_checked_count"`
+	RepositoriesCheckedFailedCount          int        `json:"repositories_checked_failed_count"`
+	RepositoriesCheckedInPercentage         string     `json:"repositories_checked_in_percentage"`
+	LastEventID                             int        `json:"last_event_id"`
+	LastEventTimestamp                      int        `json:"last_event_timestamp"`
+	CursorLastEventID                       int        `json:"cursor_last_event_id"`
+	CursorLastEventTimestamp                int        `json:"cursor_last_event_timestamp"`
+	LastSuccessfulStatusCheckTimestamp      int        `json:"last_successful_status_check_timestamp"`
+	Version                                 string     `json:"version"`
+	Revision                                string     `json:"revision"`
+	StorageShardsMatch                      bool       `json:"storage_shards_match"`
+	UpdatedAt                               *time.Time `json:"updated_at"`
+	StorageShards                           []struct {
+		Name string `json:"name"`
+	} `json:"storage_shards"`
+}
+
+// RetrieveStatusOfAllGeoNodes gets the status of all Geo nodes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-all-geo-nodes
+func (s *GeoNodesService) RetrieveStatusOfAllGeoNodes(options ...RequestOptionFunc) ([]*GeoNodeStatus, *Response, error) {
+	req, err := s.client.NewRequest("GET", "geo_nodes/status", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gnss []*GeoNodeStatus
+	resp, err := s.client.Do(req, &gnss)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gnss, resp, err
+}
+
+// RetrieveStatusOfGeoNode gets the status of a specific Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-a-specific-geo-node
+func (s *GeoNodesService) RetrieveStatusOfGeoNode(id int, options ...RequestOptionFunc) (*GeoNodeStatus, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d/status", id)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gns := new(GeoNodeStatus)
+	resp, err := s.client.Do(req, gns)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gns, resp, err
+}
@@ -0,0 +1,153 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreateGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"primary":false,"name":"secondary","url":"https://secondary.example.com/","selective_sync_type":"shards","selective_sync_shards":["default"]}`)
+		fmt.Fprint(w, `{"id":3,"name":"secondary","url":"https://secondary.example.com/","primary":false,"enabled":true,"selective_sync_type":"shards","selective_sync_shards":["default"],"_links":{"self":"https://primary.example.com/api/v4/geo_nodes/3","status":"https://primary.example.com/api/v4/geo_nodes/3/status","repair":"https://primary.example.com/api/v4/geo_nodes/3/repair"}}`)
+	})
+
+	opt := &CreateGeoNodesOptions{
+		Primary:             Bool(false),
+		Name:                String("secondary"),
+		URL:                 String("https://secondary.example.com/"),
+		SelectiveSyncType:   String("shards"),
+		SelectiveSyncShards: &[]string{"default"},
+	}
+	node, _, err := client.GeoNodes.CreateGeoNode(opt)
+	if err != nil {
+		t.Fatalf("GeoNodes.CreateGeoNode returned error: %v", err)
+	}
+
+	want := &GeoNode{
+		ID:                  3,
+		Name:                "secondary",
+		URL:                 "https://secondary.example.com/",
+		Enabled:             true,
+		SelectiveSyncType:   "shards",
+		SelectiveSyncShards: []string{"default"},
+		Links: GeoNodeLinks{
+			Self:   "https://primary.example.com/api/v4/geo_nodes/3",
+			Status: "https://primary.example.com/api/v4/geo_nodes/3/status",
+			Repair: "https://primary.example.com/api/v4/geo_nodes/3/repair",
+		},
+	}
+	if !reflect.DeepEqual(want, node) {
+		t.Errorf("GeoNodes.CreateGeoNode returned %+v, want %+v", node, want)
+	}
+}
+
+func TestEditGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":false,"files_max_capacity":20}`)
+		fmt.Fprint(w, `{"id":3,"enabled":false,"files_max_capacity":20}`)
+	})
+
+	opt := &UpdateGeoNodesOptions{Enabled: Bool(false), FilesMaxCapacity: Int(20)}
+	node, _, err := client.GeoNodes.EditGeoNode(3, opt)
+	if err != nil {
+		t.Fatalf("GeoNodes.EditGeoNode returned error: %v", err)
+	}
+
+	want := &GeoNode{ID: 3, FilesMaxCapacity: 20}
+	if !reflect.DeepEqual(want, node) {
+		t.Errorf("GeoNodes.EditGeoNode returned %+v, want %+v", node, want)
+	}
+}
+
+func TestDeleteGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.GeoNodes.DeleteGeoNode(3)
+	if err != nil {
+		t.Fatalf("GeoNodes.DeleteGeoNode returned error: %v", err)
+	}
+}
+
+func TestRepairGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/3/repair", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":3,"enabled":true}`)
+	})
+
+	node, _, err := client.GeoNodes.RepairGeoNode(3)
+	if err != nil {
+		t.Fatalf("GeoNodes.RepairGeoNode returned error: %v", err)
+	}
+
+	want := &GeoNode{ID: 3, Enabled: true}
+	if !reflect.DeepEqual(want, node) {
+		t.Errorf("GeoNodes.RepairGeoNode returned %+v, want %+v", node, want)
+	}
+}
+
+func TestRetrieveStatusOfAllGeoNodes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"geo_node_id":2,"healthy":true,"health":"Healthy","health_status":"Healthy","db_replication_lag_seconds":3,"repositories_count":10,"repositories_synced_count":5,"repositories_synced_in_percentage":"50.00%","storage_shards":[{"name":"default"}]}]`)
+	})
+
+	statuses, _, err := client.GeoNodes.RetrieveStatusOfAllGeoNodes()
+	if err != nil {
+		t.Fatalf("GeoNodes.RetrieveStatusOfAllGeoNodes returned error: %v", err)
+	}
+
+	if len(statuses) != 1 {
+		t.Fatalf("GeoNodes.RetrieveStatusOfAllGeoNodes returned %d statuses, want 1", len(statuses))
+	}
+	status := statuses[0]
+	if status.GeoNodeID != 2 || !status.Healthy || status.DBReplicationLagSeconds != 3 {
+		t.Errorf("GeoNodes.RetrieveStatusOfAllGeoNodes returned %+v", status)
+	}
+	if status.RepositoriesSyncedInPercentage != "50.00%" {
+		t.Errorf("RepositoriesSyncedInPercentage is %s, want %s", status.RepositoriesSyncedInPercentage, "50.00%")
+	}
+	if len(status.StorageShards) != 1 || status.StorageShards[0].Name != "default" {
+		t.Errorf("StorageShards is %+v, want a single default shard", status.StorageShards)
+	}
+}
+
+func TestRetrieveStatusOfGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/2/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"geo_node_id":2,"healthy":false,"health":"Geo node has database issues"}`)
+	})
+
+	status, _, err := client.GeoNodes.RetrieveStatusOfGeoNode(2)
+	if err != nil {
+		t.Fatalf("GeoNodes.RetrieveStatusOfGeoNode returned error: %v", err)
+	}
+
+	want := &GeoNodeStatus{GeoNodeID: 2, Health: "Geo node has database issues"}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("GeoNodes.RetrieveStatusOfGeoNode returned %+v, want %+v", status, want)
+	}
+}
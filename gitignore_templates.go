@@ -33,6 +33,7 @@ type GitIgnoreTemplatesService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/templates/gitignores.html
 type GitIgnoreTemplate struct {
+	Key     string `json:"key"`
 	Name    string `json:"name"`
 	Content string `json:"content"`
 }
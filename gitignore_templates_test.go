@@ -0,0 +1,49 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListGitIgnoreTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/gitignores", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/templates/gitignores?page=2&per_page=2")
+		fmt.Fprint(w, `[{"key":"Go","name":"Go"},{"key":"Node","name":"Node"}]`)
+	})
+
+	templates, _, err := client.GitIgnoreTemplates.ListTemplates(&ListTemplatesOptions{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatalf("GitIgnoreTemplates.ListTemplates returned error: %v", err)
+	}
+
+	want := []*GitIgnoreTemplate{{Key: "Go", Name: "Go"}, {Key: "Node", Name: "Node"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("GitIgnoreTemplates.ListTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetGitIgnoreTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/gitignores/Go", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"Go","content":"*.exe\n*.test\n"}`)
+	})
+
+	template, _, err := client.GitIgnoreTemplates.GetTemplate("Go")
+	if err != nil {
+		t.Fatalf("GitIgnoreTemplates.GetTemplate returned error: %v", err)
+	}
+
+	want := &GitIgnoreTemplate{Name: "Go", Content: "*.exe\n*.test\n"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("GitIgnoreTemplates.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
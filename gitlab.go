@@ -24,6 +24,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"mime"
 	"net/http"
 	"net/url"
 	"sort"
@@ -98,82 +99,94 @@ type Client struct {
 	UserAgent string
 
 	// Services used for talking to different parts of the GitLab API.
-	AccessRequests        *AccessRequestsService
-	Applications          *ApplicationsService
-	AwardEmoji            *AwardEmojiService
-	Boards                *IssueBoardsService
-	Branches              *BranchesService
-	BroadcastMessage      *BroadcastMessagesService
-	CIYMLTemplate         *CIYMLTemplatesService
-	Commits               *CommitsService
-	ContainerRegistry     *ContainerRegistryService
-	CustomAttribute       *CustomAttributesService
-	DeployKeys            *DeployKeysService
-	DeployTokens          *DeployTokensService
-	Deployments           *DeploymentsService
-	Discussions           *DiscussionsService
-	Environments          *EnvironmentsService
-	EpicIssues            *EpicIssuesService
-	Epics                 *EpicsService
-	Events                *EventsService
-	Features              *FeaturesService
-	GitIgnoreTemplates    *GitIgnoreTemplatesService
-	GroupBadges           *GroupBadgesService
-	GroupCluster          *GroupClustersService
-	GroupIssueBoards      *GroupIssueBoardsService
-	GroupLabels           *GroupLabelsService
-	GroupMembers          *GroupMembersService
-	GroupMilestones       *GroupMilestonesService
-	GroupVariables        *GroupVariablesService
-	Groups                *GroupsService
-	InstanceCluster       *InstanceClustersService
-	InstanceVariables     *InstanceVariablesService
-	IssueLinks            *IssueLinksService
-	Issues                *IssuesService
-	IssuesStatistics      *IssuesStatisticsService
-	Jobs                  *JobsService
-	Keys                  *KeysService
-	Labels                *LabelsService
-	License               *LicenseService
-	LicenseTemplates      *LicenseTemplatesService
-	MergeRequestApprovals *MergeRequestApprovalsService
-	MergeRequests         *MergeRequestsService
-	Milestones            *MilestonesService
-	Namespaces            *NamespacesService
-	Notes                 *NotesService
-	NotificationSettings  *NotificationSettingsService
-	PagesDomains          *PagesDomainsService
-	PipelineSchedules     *PipelineSchedulesService
-	PipelineTriggers      *PipelineTriggersService
-	Pipelines             *PipelinesService
-	ProjectBadges         *ProjectBadgesService
-	ProjectCluster        *ProjectClustersService
-	ProjectImportExport   *ProjectImportExportService
-	ProjectMembers        *ProjectMembersService
-	ProjectMirrors        *ProjectMirrorService
-	ProjectSnippets       *ProjectSnippetsService
-	ProjectVariables      *ProjectVariablesService
-	Projects              *ProjectsService
-	ProtectedBranches     *ProtectedBranchesService
-	ProtectedTags         *ProtectedTagsService
-	ReleaseLinks          *ReleaseLinksService
-	Releases              *ReleasesService
-	Repositories          *RepositoriesService
-	RepositoryFiles       *RepositoryFilesService
-	ResourceLabelEvents   *ResourceLabelEventsService
-	Runners               *RunnersService
-	Search                *SearchService
-	Services              *ServicesService
-	Settings              *SettingsService
-	Sidekiq               *SidekiqService
-	Snippets              *SnippetsService
-	SystemHooks           *SystemHooksService
-	Tags                  *TagsService
-	Todos                 *TodosService
-	Users                 *UsersService
-	Validate              *ValidateService
-	Version               *VersionService
-	Wikis                 *WikisService
+	AccessRequests                *AccessRequestsService
+	Applications                  *ApplicationsService
+	AuditEvents                   *AuditEventsService
+	AwardEmoji                    *AwardEmojiService
+	Boards                        *IssueBoardsService
+	Branches                      *BranchesService
+	BroadcastMessage              *BroadcastMessagesService
+	CIYMLTemplate                 *CIYMLTemplatesService
+	Commits                       *CommitsService
+	ContainerRegistry             *ContainerRegistryService
+	CustomAttribute               *CustomAttributesService
+	DeployKeys                    *DeployKeysService
+	DeployTokens                  *DeployTokensService
+	Deployments                   *DeploymentsService
+	Discussions                   *DiscussionsService
+	DockerfileTemplate            *DockerfileTemplatesService
+	DraftNotes                    *DraftNotesService
+	Environments                  *EnvironmentsService
+	EpicIssues                    *EpicIssuesService
+	Epics                         *EpicsService
+	Events                        *EventsService
+	Features                      *FeaturesService
+	FreezePeriods                 *FreezePeriodsService
+	GeoNodes                      *GeoNodesService
+	GitIgnoreTemplates            *GitIgnoreTemplatesService
+	GroupBadges                   *GroupBadgesService
+	GroupCluster                  *GroupClustersService
+	GroupIssueBoards              *GroupIssueBoardsService
+	GroupLabels                   *GroupLabelsService
+	GroupMembers                  *GroupMembersService
+	GroupMilestones               *GroupMilestonesService
+	GroupVariables                *GroupVariablesService
+	Groups                        *GroupsService
+	InstanceCluster               *InstanceClustersService
+	InstanceVariables             *InstanceVariablesService
+	IssueLinks                    *IssueLinksService
+	Issues                        *IssuesService
+	IssuesStatistics              *IssuesStatisticsService
+	Jobs                          *JobsService
+	Keys                          *KeysService
+	Labels                        *LabelsService
+	License                       *LicenseService
+	LicenseTemplates              *LicenseTemplatesService
+	MergeRequestApprovals         *MergeRequestApprovalsService
+	MergeRequests                 *MergeRequestsService
+	Milestones                    *MilestonesService
+	Namespaces                    *NamespacesService
+	Notes                         *NotesService
+	NotificationSettings          *NotificationSettingsService
+	Packages                      *PackagesService
+	PagesDomains                  *PagesDomainsService
+	PersonalAccessTokens          *PersonalAccessTokensService
+	PipelineSchedules             *PipelineSchedulesService
+	PipelineTriggers              *PipelineTriggersService
+	Pipelines                     *PipelinesService
+	PlanLimits                    *PlanLimitsService
+	ProjectBadges                 *ProjectBadgesService
+	ProjectCluster                *ProjectClustersService
+	ProjectImportExport           *ProjectImportExportService
+	ProjectMembers                *ProjectMembersService
+	ProjectMirrors                *ProjectMirrorService
+	ProjectRepositoryStorageMoves *ProjectRepositoryStorageMovesService
+	ProjectSnippets               *ProjectSnippetsService
+	ProjectTemplates              *ProjectTemplatesService
+	ProjectVariables              *ProjectVariablesService
+	Projects                      *ProjectsService
+	ProtectedBranches             *ProtectedBranchesService
+	ProtectedTags                 *ProtectedTagsService
+	ReleaseLinks                  *ReleaseLinksService
+	Releases                      *ReleasesService
+	Repositories                  *RepositoriesService
+	RepositoryFiles               *RepositoryFilesService
+	ResourceLabelEvents           *ResourceLabelEventsService
+	Runners                       *RunnersService
+	Search                        *SearchService
+	Services                      *ServicesService
+	Settings                      *SettingsService
+	Sidekiq                       *SidekiqService
+	Snippets                      *SnippetsService
+	Suggestions                   *SuggestionsService
+	SystemHooks                   *SystemHooksService
+	Tags                          *TagsService
+	Todos                         *TodosService
+	UsageData                     *UsageDataService
+	Users                         *UsersService
+	Validate                      *ValidateService
+	Version                       *VersionService
+	Wikis                         *WikisService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -263,6 +276,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	// Create all the public services.
 	c.AccessRequests = &AccessRequestsService{client: c}
 	c.Applications = &ApplicationsService{client: c}
+	c.AuditEvents = &AuditEventsService{client: c}
 	c.AwardEmoji = &AwardEmojiService{client: c}
 	c.Boards = &IssueBoardsService{client: c}
 	c.Branches = &BranchesService{client: c}
@@ -275,11 +289,15 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.DeployTokens = &DeployTokensService{client: c}
 	c.Deployments = &DeploymentsService{client: c}
 	c.Discussions = &DiscussionsService{client: c}
+	c.DockerfileTemplate = &DockerfileTemplatesService{client: c}
+	c.DraftNotes = &DraftNotesService{client: c}
 	c.Environments = &EnvironmentsService{client: c}
 	c.EpicIssues = &EpicIssuesService{client: c}
 	c.Epics = &EpicsService{client: c}
 	c.Events = &EventsService{client: c}
 	c.Features = &FeaturesService{client: c}
+	c.FreezePeriods = &FreezePeriodsService{client: c}
+	c.GeoNodes = &GeoNodesService{client: c}
 	c.GitIgnoreTemplates = &GitIgnoreTemplatesService{client: c}
 	c.GroupBadges = &GroupBadgesService{client: c}
 	c.GroupCluster = &GroupClustersService{client: c}
@@ -290,6 +308,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.GroupVariables = &GroupVariablesService{client: c}
 	c.Groups = &GroupsService{client: c}
 	c.InstanceCluster = &InstanceClustersService{client: c}
+	c.InstanceVariables = &InstanceVariablesService{client: c}
 	c.IssueLinks = &IssueLinksService{client: c}
 	c.Issues = &IssuesService{client: c, timeStats: timeStats}
 	c.IssuesStatistics = &IssuesStatisticsService{client: c}
@@ -304,16 +323,21 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Namespaces = &NamespacesService{client: c}
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
+	c.Packages = &PackagesService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
+	c.PersonalAccessTokens = &PersonalAccessTokensService{client: c}
 	c.PipelineSchedules = &PipelineSchedulesService{client: c}
 	c.PipelineTriggers = &PipelineTriggersService{client: c}
 	c.Pipelines = &PipelinesService{client: c}
+	c.PlanLimits = &PlanLimitsService{client: c}
 	c.ProjectBadges = &ProjectBadgesService{client: c}
 	c.ProjectCluster = &ProjectClustersService{client: c}
 	c.ProjectImportExport = &ProjectImportExportService{client: c}
 	c.ProjectMembers = &ProjectMembersService{client: c}
 	c.ProjectMirrors = &ProjectMirrorService{client: c}
+	c.ProjectRepositoryStorageMoves = &ProjectRepositoryStorageMovesService{client: c}
 	c.ProjectSnippets = &ProjectSnippetsService{client: c}
+	c.ProjectTemplates = &ProjectTemplatesService{client: c}
 	c.ProjectVariables = &ProjectVariablesService{client: c}
 	c.Projects = &ProjectsService{client: c}
 	c.ProtectedBranches = &ProtectedBranchesService{client: c}
@@ -329,9 +353,11 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Settings = &SettingsService{client: c}
 	c.Sidekiq = &SidekiqService{client: c}
 	c.Snippets = &SnippetsService{client: c}
+	c.Suggestions = &SuggestionsService{client: c}
 	c.SystemHooks = &SystemHooksService{client: c}
 	c.Tags = &TagsService{client: c}
 	c.Todos = &TodosService{client: c}
+	c.UsageData = &UsageDataService{client: c}
 	c.Users = &UsersService{client: c}
 	c.Validate = &ValidateService{client: c}
 	c.Version = &VersionService{client: c}
@@ -472,7 +498,8 @@ func (c *Client) setBaseURL(urlStr string) error {
 // path, in which case it is resolved relative to the base URL of the Client.
 // Relative URL paths should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
+// request body, unless it is of type url.Values in which case it is form
+// encoded instead.
 func (c *Client) NewRequest(method, path string, opt interface{}, options []RequestOptionFunc) (*retryablehttp.Request, error) {
 	u := *c.baseURL
 	unescaped, err := url.PathUnescape(path)
@@ -495,6 +522,12 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 	var body interface{}
 	switch {
 	case method == "POST" || method == "PUT":
+		if form, ok := opt.(url.Values); ok {
+			reqHeaders.Set("Content-Type", "application/x-www-form-urlencoded")
+			body = []byte(form.Encode())
+			break
+		}
+
 		reqHeaders.Set("Content-Type", "application/json")
 
 		if opt != nil {
@@ -549,12 +582,20 @@ type Response struct {
 	CurrentPage  int
 	NextPage     int
 	PreviousPage int
+
+	// These fields provide the links for paginating through a set of results
+	// using keyset-based pagination, as parsed from the Link header.
+	FirstLink    string
+	NextLink     string
+	PreviousLink string
+	LastLink     string
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
+	response.populateLinkValues()
 	return response
 }
 
@@ -565,6 +606,7 @@ const (
 	xPage       = "X-Page"
 	xNextPage   = "X-Next-Page"
 	xPrevPage   = "X-Prev-Page"
+	linkHeader  = "Link"
 )
 
 // populatePageValues parses the HTTP Link response headers and populates the
@@ -590,6 +632,51 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// populateLinkValues parses the HTTP Link response header and populates the
+// various keyset pagination link values in the Response.
+func (r *Response) populateLinkValues() {
+	link := r.Response.Header.Get(linkHeader)
+	if link == "" {
+		return
+	}
+
+	for _, l := range strings.Split(link, ",") {
+		parts := strings.Split(l, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		value := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+		for _, param := range parts[1:] {
+			kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(kv) != 2 || kv[0] != "rel" {
+				continue
+			}
+
+			switch strings.Trim(kv[1], `"`) {
+			case "first":
+				r.FirstLink = value
+			case "next":
+				r.NextLink = value
+			case "prev":
+				r.PreviousLink = value
+			case "last":
+				r.LastLink = value
+			}
+		}
+	}
+}
+
+// Filename returns the file name from the Content-Disposition header of the
+// response, or an empty string if the header doesn't contain a file name.
+func (r *Response) Filename() string {
+	_, params, err := mime.ParseMediaType(r.Response.Header.Get("Content-Disposition"))
+	if err != nil {
+		return ""
+	}
+	return params["filename"]
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
@@ -707,6 +794,16 @@ func pathEscape(s string) string {
 	return strings.Replace(url.PathEscape(s), ".", "%2E", -1)
 }
 
+// Helper function to escape a path that may contain multiple segments, like
+// a file path. Each segment is escaped individually so the slashes are kept.
+func pathSegmentsEscape(s string) string {
+	segments := strings.Split(s, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
 // An ErrorResponse reports one or more errors caused by an API request.
 //
 // GitLab API docs:
@@ -715,6 +812,10 @@ type ErrorResponse struct {
 	Body     []byte
 	Response *http.Response
 	Message  string
+
+	// ErrorCode contains the machine readable error code some endpoints
+	// return next to the message, e.g. "conflict" for a failed cherry-pick.
+	ErrorCode string
 }
 
 func (e *ErrorResponse) Error() string {
@@ -740,6 +841,9 @@ func CheckResponse(r *http.Response) error {
 			errorResponse.Message = "failed to parse unknown error format"
 		} else {
 			errorResponse.Message = parseError(raw)
+			if m, ok := raw.(map[string]interface{}); ok {
+				errorResponse.ErrorCode, _ = m["error_code"].(string)
+			}
 		}
 	}
 
@@ -155,6 +155,24 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestResponseLinkValues(t *testing.T) {
+	r := &http.Response{Header: http.Header{}}
+	r.Header.Set("Link", `<https://gitlab.example.com/api/v4/projects?id_after=42&pagination=keyset>; rel="next", `+
+		`<https://gitlab.example.com/api/v4/projects?pagination=keyset>; rel="first"`)
+
+	resp := newResponse(r)
+
+	if want := "https://gitlab.example.com/api/v4/projects?id_after=42&pagination=keyset"; resp.NextLink != want {
+		t.Errorf("NextLink is %q, want %q", resp.NextLink, want)
+	}
+	if want := "https://gitlab.example.com/api/v4/projects?pagination=keyset"; resp.FirstLink != want {
+		t.Errorf("FirstLink is %q, want %q", resp.FirstLink, want)
+	}
+	if resp.PreviousLink != "" || resp.LastLink != "" {
+		t.Errorf("PreviousLink and LastLink should be empty, got %q and %q", resp.PreviousLink, resp.LastLink)
+	}
+}
+
 func TestRequestWithContext(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
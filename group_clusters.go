@@ -37,6 +37,8 @@ type GroupCluster struct {
 	ID                 int                 `json:"id"`
 	Name               string              `json:"name"`
 	Domain             string              `json:"domain"`
+	Enabled            bool                `json:"enabled"`
+	Managed            bool                `json:"managed"`
 	CreatedAt          *time.Time          `json:"created_at"`
 	ProviderType       string              `json:"provider_type"`
 	PlatformType       string              `json:"platform_type"`
@@ -157,6 +159,8 @@ func (s *GroupClustersService) AddCluster(pid interface{}, opt *AddGroupClusterO
 type EditGroupClusterOptions struct {
 	Name                *string                             `url:"name,omitempty" json:"name,omitempty"`
 	Domain              *string                             `url:"domain,omitempty" json:"domain,omitempty"`
+	Enabled             *bool                               `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Managed             *bool                               `url:"managed,omitempty" json:"managed,omitempty"`
 	EnvironmentScope    *string                             `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 	PlatformKubernetes  *EditGroupPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
 	ManagementProjectID *string                             `url:"management_project_id,omitempty" json:"management_project_id,omitempty"`
@@ -0,0 +1,118 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGroupListClusters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/26/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":18,"name":"cluster-18","domain":"example.com","enabled":true,"managed":true,"environment_scope":"*","cluster_type":"group_type","platform_kubernetes":{"api_url":"https://104.197.68.152","authorization_type":"rbac"},"group":{"id":26,"name":"group-with-clusters-api"}}]`)
+	})
+
+	clusters, _, err := client.GroupCluster.ListClusters(26)
+	if err != nil {
+		t.Fatalf("GroupClusters.ListClusters returned error: %v", err)
+	}
+
+	want := []*GroupCluster{{
+		ID:               18,
+		Name:             "cluster-18",
+		Domain:           "example.com",
+		Enabled:          true,
+		Managed:          true,
+		EnvironmentScope: "*",
+		ClusterType:      "group_type",
+		PlatformKubernetes: &PlatformKubernetes{
+			APIURL:            "https://104.197.68.152",
+			AuthorizationType: "rbac",
+		},
+		Group: &Group{ID: 26, Name: "group-with-clusters-api"},
+	}}
+	if !reflect.DeepEqual(want, clusters) {
+		t.Errorf("GroupClusters.ListClusters returned %+v, want %+v", clusters, want)
+	}
+}
+
+func TestGroupAddCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/26/clusters/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"review-apps","domain":"review.example.com","management_project_id":"17","enabled":true,"managed":false,"environment_scope":"review/*","platform_kubernetes_attributes":{"api_url":"https://k8s.example.com","token":"secret","authorization_type":"rbac"}}`)
+		fmt.Fprint(w, `{"id":24,"name":"review-apps","domain":"review.example.com","enabled":true,"environment_scope":"review/*","cluster_type":"group_type"}`)
+	})
+
+	opt := &AddGroupClusterOptions{
+		Name:                String("review-apps"),
+		Domain:              String("review.example.com"),
+		ManagementProjectID: String("17"),
+		Enabled:             Bool(true),
+		Managed:             Bool(false),
+		EnvironmentScope:    String("review/*"),
+		PlatformKubernetes: &AddGroupPlatformKubernetesOptions{
+			APIURL:            String("https://k8s.example.com"),
+			Token:             String("secret"),
+			AuthorizationType: String("rbac"),
+		},
+	}
+	cluster, _, err := client.GroupCluster.AddCluster(26, opt)
+	if err != nil {
+		t.Fatalf("GroupClusters.AddCluster returned error: %v", err)
+	}
+
+	want := &GroupCluster{
+		ID:               24,
+		Name:             "review-apps",
+		Domain:           "review.example.com",
+		Enabled:          true,
+		EnvironmentScope: "review/*",
+		ClusterType:      "group_type",
+	}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("GroupClusters.AddCluster returned %+v, want %+v", cluster, want)
+	}
+}
+
+func TestGroupEditCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/26/clusters/24", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":false}`)
+		fmt.Fprint(w, `{"id":24,"name":"review-apps","enabled":false}`)
+	})
+
+	cluster, _, err := client.GroupCluster.EditCluster(26, 24, &EditGroupClusterOptions{Enabled: Bool(false)})
+	if err != nil {
+		t.Fatalf("GroupClusters.EditCluster returned error: %v", err)
+	}
+
+	want := &GroupCluster{ID: 24, Name: "review-apps"}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("GroupClusters.EditCluster returned %+v, want %+v", cluster, want)
+	}
+}
+
+func TestGroupDeleteCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/26/clusters/24", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.GroupCluster.DeleteCluster(26, 24)
+	if err != nil {
+		t.Fatalf("GroupClusters.DeleteCluster returned error: %v", err)
+	}
+}
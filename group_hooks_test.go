@@ -191,6 +191,75 @@ func TestAddGroupHook(t *testing.T) {
 	}
 }
 
+func TestAddGroupHookWithSubGroupEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"url":"https://audit.example.com/hook","push_events":true,"push_events_branch_filter":"main","subgroup_events":true,"token":"secret"}`)
+		fmt.Fprint(w, `{"id":2,"url":"https://audit.example.com/hook","group_id":1,"push_events":true,"push_events_branch_filter":"main","subgroup_events":true}`)
+	})
+
+	opt := &AddGroupHookOptions{
+		URL:                    String("https://audit.example.com/hook"),
+		PushEvents:             Bool(true),
+		PushEventsBranchFilter: String("main"),
+		SubGroupEvents:         Bool(true),
+		Token:                  String("secret"),
+	}
+
+	groupHook, _, err := client.Groups.AddGroupHook(1, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &GroupHook{
+		ID:                     2,
+		URL:                    "https://audit.example.com/hook",
+		GroupID:                1,
+		PushEvents:             true,
+		PushEventsBranchFilter: "main",
+		SubGroupEvents:         true,
+	}
+
+	if !reflect.DeepEqual(groupHook, want) {
+		t.Errorf("AddGroupHook returned \ngot:\n%v\nwant:\n%v", Stringify(groupHook), Stringify(want))
+	}
+}
+
+func TestEditGroupHookPartial(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"push_events":false,"releases_events":true}`)
+		fmt.Fprint(w, `{"id":2,"url":"https://audit.example.com/hook","group_id":1,"push_events":false,"releases_events":true}`)
+	})
+
+	opt := &EditGroupHookOptions{
+		PushEvents:     Bool(false),
+		ReleasesEvents: Bool(true),
+	}
+
+	groupHook, _, err := client.Groups.EditGroupHook(1, 2, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &GroupHook{
+		ID:             2,
+		URL:            "https://audit.example.com/hook",
+		GroupID:        1,
+		ReleasesEvents: true,
+	}
+
+	if !reflect.DeepEqual(groupHook, want) {
+		t.Errorf("EditGroupHook returned \ngot:\n%v\nwant:\n%v", Stringify(groupHook), Stringify(want))
+	}
+}
+
 func TestEditGroupHook(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
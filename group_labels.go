@@ -23,8 +23,16 @@ func (l GroupLabel) String() string {
 
 // ListGroupLabelsOptions represents the available ListGroupLabels() options.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#list-labels
-type ListGroupLabelsOptions ListOptions
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_labels.html#list-group-labels
+type ListGroupLabelsOptions struct {
+	ListOptions
+	WithCounts              *bool   `url:"with_counts,omitempty" json:"with_counts,omitempty"`
+	IncludeAncestorGroups   *bool   `url:"include_ancestor_groups,omitempty" json:"include_ancestor_groups,omitempty"`
+	IncludeDescendantGroups *bool   `url:"include_descendant_groups,omitempty" json:"include_descendant_groups,omitempty"`
+	OnlyGroupLabels         *bool   `url:"only_group_labels,omitempty" json:"only_group_labels,omitempty"`
+	Search                  *string `url:"search,omitempty" json:"search,omitempty"`
+}
 
 // ListGroupLabels gets all labels for given group.
 //
@@ -64,15 +72,15 @@ func (s *GroupLabelsService) GetGroupLabel(gid interface{}, labelID interface{},
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/labels/%s", pathEscape(group), label)
+	u := fmt.Sprintf("groups/%s/labels/%s", pathEscape(group), pathEscape(label))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var l *GroupLabel
-	resp, err := s.client.Do(req, &l)
+	l := new(GroupLabel)
+	resp, err := s.client.Do(req, l)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -120,7 +128,8 @@ type DeleteGroupLabelOptions DeleteLabelOptions
 
 // DeleteGroupLabel deletes a group label given by its name.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#delete-a-label
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_labels.html#delete-a-group-label
 func (s *GroupLabelsService) DeleteGroupLabel(gid interface{}, opt *DeleteGroupLabelOptions, options ...RequestOptionFunc) (*Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
@@ -183,7 +192,7 @@ func (s *GroupLabelsService) SubscribeToGroupLabel(gid interface{}, labelID inte
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/labels/%s/subscribe", pathEscape(group), label)
+	u := fmt.Sprintf("groups/%s/labels/%s/subscribe", pathEscape(group), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -214,7 +223,7 @@ func (s *GroupLabelsService) UnsubscribeFromGroupLabel(gid interface{}, labelID
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("groups/%s/labels/%s/unsubscribe", pathEscape(group), label)
+	u := fmt.Sprintf("groups/%s/labels/%s/unsubscribe", pathEscape(group), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
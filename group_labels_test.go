@@ -125,8 +125,10 @@ func TestListGroupLabels(t *testing.T) {
 	})
 
 	o := &ListGroupLabelsOptions{
-		Page:    1,
-		PerPage: 10,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 10,
+		},
 	}
 	label, _, err := client.GroupLabels.ListGroupLabels("1", o)
 	if err != nil {
@@ -158,3 +160,50 @@ func TestGetGroupLabel(t *testing.T) {
 		t.Errorf("GroupLabels.GetGroupLabel returned %+v, want %+v", label, want)
 	}
 }
+
+func TestListGroupLabelsWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/labels?include_ancestor_groups=false&search=prio&with_counts=true")
+		fmt.Fprint(w, `[{"id":7,"name":"priority::high","color":"#ff0000","open_issues_count":3}]`)
+	})
+
+	o := &ListGroupLabelsOptions{
+		WithCounts:            Bool(true),
+		IncludeAncestorGroups: Bool(false),
+		Search:                String("prio"),
+	}
+	labels, _, err := client.GroupLabels.ListGroupLabels(1, o)
+	if err != nil {
+		t.Fatalf("GroupLabels.ListGroupLabels returned error: %v", err)
+	}
+
+	want := []*GroupLabel{{ID: 7, Name: "priority::high", Color: "#ff0000", OpenIssuesCount: 3}}
+	if !reflect.DeepEqual(want, labels) {
+		t.Errorf("GroupLabels.ListGroupLabels returned %+v, want %+v", labels, want)
+	}
+}
+
+func TestGetGroupLabelByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/labels/needs%20review")
+		fmt.Fprint(w, `{"id":8,"name":"needs review","color":"#428bca"}`)
+	})
+
+	label, _, err := client.GroupLabels.GetGroupLabel(1, "needs review")
+	if err != nil {
+		t.Fatalf("GroupLabels.GetGroupLabel returned error: %v", err)
+	}
+
+	want := &GroupLabel{ID: 8, Name: "needs review", Color: "#428bca"}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("GroupLabels.GetGroupLabel returned %+v, want %+v", label, want)
+	}
+}
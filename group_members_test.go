@@ -0,0 +1,188 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListAllGroupMembersWithUserIDs(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/members/all?user_ids%5B%5D=3&user_ids%5B%5D=5")
+		fmt.Fprint(w, `[{"id":3,"username":"raymond_smith","access_level":30,"expires_at":"2021-12-31"},{"id":5,"username":"john_doe","access_level":50,"expires_at":null}]`)
+	})
+
+	opt := &ListGroupMembersOptions{UserIDs: []int{3, 5}}
+
+	members, _, err := client.Groups.ListAllGroupMembers(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ListAllGroupMembers returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC))
+	want := []*GroupMember{
+		{ID: 3, Username: "raymond_smith", AccessLevel: DeveloperPermissions, ExpiresAt: &expiresAt},
+		{ID: 5, Username: "john_doe", AccessLevel: OwnerPermissions},
+	}
+	if !reflect.DeepEqual(want, members) {
+		t.Errorf("Groups.ListAllGroupMembers returned %+v, want %+v", members, want)
+	}
+}
+
+func TestGetInheritedGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/all/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,"username":"raymond_smith","access_level":40}`)
+	})
+
+	member, _, err := client.GroupMembers.GetInheritedGroupMember(1, 3)
+	if err != nil {
+		t.Fatalf("GroupMembers.GetInheritedGroupMember returned error: %v", err)
+	}
+
+	want := &GroupMember{ID: 3, Username: "raymond_smith", AccessLevel: MaintainerPermissions}
+	if !reflect.DeepEqual(want, member) {
+		t.Errorf("GroupMembers.GetInheritedGroupMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestAddGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"user_id":3,"access_level":30,"expires_at":"2021-12-31"}`)
+		fmt.Fprint(w, `{"id":3,"username":"raymond_smith","access_level":30,"expires_at":"2021-12-31"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC))
+	opt := &AddGroupMemberOptions{
+		UserID:      Int(3),
+		AccessLevel: AccessLevel(DeveloperPermissions),
+		ExpiresAt:   &expiresAt,
+	}
+
+	member, _, err := client.GroupMembers.AddGroupMember(1, opt)
+	if err != nil {
+		t.Fatalf("GroupMembers.AddGroupMember returned error: %v", err)
+	}
+
+	want := &GroupMember{ID: 3, Username: "raymond_smith", AccessLevel: DeveloperPermissions, ExpiresAt: &expiresAt}
+	if !reflect.DeepEqual(want, member) {
+		t.Errorf("GroupMembers.AddGroupMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestEditGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"access_level":40,"expires_at":"2022-06-30"}`)
+		fmt.Fprint(w, `{"id":3,"username":"raymond_smith","access_level":40,"expires_at":"2022-06-30"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2022, 6, 30, 15, 4, 5, 0, time.UTC))
+	opt := &EditGroupMemberOptions{
+		AccessLevel: AccessLevel(MaintainerPermissions),
+		ExpiresAt:   &expiresAt,
+	}
+
+	member, _, err := client.GroupMembers.EditGroupMember(1, 3, opt)
+	if err != nil {
+		t.Fatalf("GroupMembers.EditGroupMember returned error: %v", err)
+	}
+
+	wantExpiresAt := ISOTime(time.Date(2022, 6, 30, 0, 0, 0, 0, time.UTC))
+	want := &GroupMember{ID: 3, Username: "raymond_smith", AccessLevel: MaintainerPermissions, ExpiresAt: &wantExpiresAt}
+	if !reflect.DeepEqual(want, member) {
+		t.Errorf("GroupMembers.EditGroupMember returned %+v, want %+v", member, want)
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/groups/1/members/3?skip_subresources=true&unassign_issuables=true")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &RemoveGroupMemberOptions{
+		SkipSubresources:  Bool(true),
+		UnassignIssuables: Bool(true),
+	}
+
+	_, err := client.GroupMembers.RemoveGroupMember(1, 3, opt)
+	if err != nil {
+		t.Fatalf("GroupMembers.RemoveGroupMember returned error: %v", err)
+	}
+}
+
+func TestListBillableGroupMembers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/billable_members?search=john&sort=last_activity_on_desc")
+		fmt.Fprint(w, `[{"id":2,"username":"john_doe","name":"John Doe","state":"active","email":"john@example.com","last_activity_on":"2021-01-27","membership_type":"group_member","removable":true}]`)
+	})
+
+	opt := &ListBillableGroupMembersOptions{
+		Search: String("john"),
+		Sort:   String("last_activity_on_desc"),
+	}
+
+	members, _, err := client.GroupMembers.ListBillableGroupMembers(1, opt)
+	if err != nil {
+		t.Fatalf("GroupMembers.ListBillableGroupMembers returned error: %v", err)
+	}
+
+	lastActivityOn := ISOTime(time.Date(2021, 1, 27, 0, 0, 0, 0, time.UTC))
+	want := []*BillableGroupMember{{
+		ID:             2,
+		Username:       "john_doe",
+		Name:           "John Doe",
+		State:          "active",
+		Email:          "john@example.com",
+		LastActivityOn: &lastActivityOn,
+		MembershipType: "group_member",
+		Removable:      true,
+	}}
+	if !reflect.DeepEqual(want, members) {
+		t.Errorf("GroupMembers.ListBillableGroupMembers returned %+v, want %+v", members, want)
+	}
+}
+
+func TestRemoveBillableGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.GroupMembers.RemoveBillableGroupMember(1, 2)
+	if err != nil {
+		t.Fatalf("GroupMembers.RemoveBillableGroupMember returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("GroupMembers.RemoveBillableGroupMember returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
@@ -46,7 +46,7 @@ func TestGetGroupVariable(t *testing.T) {
 			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value": "test1","protected": false,"masked": true}`)
 		})
 
-	variable, _, err := client.GroupVariables.GetVariable(1, "TEST_VARIABLE_1")
+	variable, _, err := client.GroupVariables.GetVariable(1, "TEST_VARIABLE_1", nil)
 
 	if err != nil {
 		t.Errorf("GroupVariables.GetVariable returned error: %v", err)
@@ -96,7 +96,7 @@ func TestDeleteGroupVariable(t *testing.T) {
 			w.WriteHeader(http.StatusAccepted)
 		})
 
-	resp, err := client.GroupVariables.RemoveVariable(1, "TEST_VARIABLE_1")
+	resp, err := client.GroupVariables.RemoveVariable(1, "TEST_VARIABLE_1", nil)
 	if err != nil {
 		t.Errorf("GroupVariables.RemoveVariable returned error: %v", err)
 	}
@@ -128,3 +128,95 @@ func TestUpdateGroupVariable(t *testing.T) {
 		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", variable, want)
 	}
 }
+
+func TestListGroupVariablesWithPagination(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/groups/1/variables?page=2&per_page=50")
+			fmt.Fprint(w, `[{"key":"DEPLOY_TOKEN","value":"secret","variable_type":"env_var","environment_scope":"production"}]`)
+		})
+
+	variables, _, err := client.GroupVariables.ListVariables(1, &ListGroupVariablesOptions{Page: 2, PerPage: 50})
+	if err != nil {
+		t.Fatalf("GroupVariables.ListVariables returned error: %v", err)
+	}
+
+	want := []*GroupVariable{{Key: "DEPLOY_TOKEN", Value: "secret", VariableType: EnvVariableType, EnvironmentScope: "production"}}
+	if !reflect.DeepEqual(want, variables) {
+		t.Errorf("GroupVariables.ListVariables returned %+v, want %+v", variables, want)
+	}
+}
+
+func TestGetGroupVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/DEPLOY_TOKEN",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/groups/1/variables/DEPLOY_TOKEN?filter%5Benvironment_scope%5D=production")
+			fmt.Fprint(w, `{"key":"DEPLOY_TOKEN","value":"secret","environment_scope":"production"}`)
+		})
+
+	opt := &GetGroupVariableOptions{Filter: &VariableFilter{EnvironmentScope: String("production")}}
+
+	variable, _, err := client.GroupVariables.GetVariable(1, "DEPLOY_TOKEN", opt)
+	if err != nil {
+		t.Fatalf("GroupVariables.GetVariable returned error: %v", err)
+	}
+
+	want := &GroupVariable{Key: "DEPLOY_TOKEN", Value: "secret", EnvironmentScope: "production"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("GroupVariables.GetVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestUpdateGroupVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/DEPLOY_TOKEN",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"value":"rotated","filter":{"environment_scope":"production"}}`)
+			fmt.Fprint(w, `{"key":"DEPLOY_TOKEN","value":"rotated","protected":true,"masked":true,"environment_scope":"production"}`)
+		})
+
+	opt := &UpdateGroupVariableOptions{
+		Value:  String("rotated"),
+		Filter: &VariableFilter{EnvironmentScope: String("production")},
+	}
+
+	variable, _, err := client.GroupVariables.UpdateVariable(1, "DEPLOY_TOKEN", opt)
+	if err != nil {
+		t.Fatalf("GroupVariables.UpdateVariable returned error: %v", err)
+	}
+
+	want := &GroupVariable{Key: "DEPLOY_TOKEN", Value: "rotated", Protected: true, Masked: true, EnvironmentScope: "production"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("GroupVariables.UpdateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestRemoveGroupVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/DEPLOY_TOKEN",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			testURL(t, r, "/api/v4/groups/1/variables/DEPLOY_TOKEN?filter%5Benvironment_scope%5D=staging")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	opt := &RemoveGroupVariableOptions{Filter: &VariableFilter{EnvironmentScope: String("staging")}}
+
+	_, err := client.GroupVariables.RemoveVariable(1, "DEPLOY_TOKEN", opt)
+	if err != nil {
+		t.Fatalf("GroupVariables.RemoveVariable returned error: %v", err)
+	}
+}
@@ -17,7 +17,11 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
 	"time"
 )
 
@@ -33,38 +37,40 @@ type GroupsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html
 type Group struct {
-	ID                    int                        `json:"id"`
-	Name                  string                     `json:"name"`
-	Path                  string                     `json:"path"`
-	Description           string                     `json:"description"`
-	MembershipLock        bool                       `json:"membership_lock"`
-	Visibility            VisibilityValue            `json:"visibility"`
-	LFSEnabled            bool                       `json:"lfs_enabled"`
-	AvatarURL             string                     `json:"avatar_url"`
-	WebURL                string                     `json:"web_url"`
-	RequestAccessEnabled  bool                       `json:"request_access_enabled"`
-	FullName              string                     `json:"full_name"`
-	FullPath              string                     `json:"full_path"`
-	ParentID              int                        `json:"parent_id"`
-	Projects              []*Project                 `json:"projects"`
-	Statistics            *StorageStatistics         `json:"statistics"`
-	CustomAttributes      []*CustomAttribute         `json:"custom_attributes"`
-	ShareWithGroupLock    bool                       `json:"share_with_group_lock"`
-	RequireTwoFactorAuth  bool                       `json:"require_two_factor_authentication"`
-	TwoFactorGracePeriod  int                        `json:"two_factor_grace_period"`
-	ProjectCreationLevel  ProjectCreationLevelValue  `json:"project_creation_level"`
-	AutoDevopsEnabled     bool                       `json:"auto_devops_enabled"`
-	SubGroupCreationLevel SubGroupCreationLevelValue `json:"subgroup_creation_level"`
-	EmailsDisabled        bool                       `json:"emails_disabled"`
-	MentionsDisabled      bool                       `json:"mentions_disabled"`
-	RunnersToken          string                     `json:"runners_token"`
-	SharedProjects        []*Project                 `json:"shared_projects"`
-	SharedWithGroups      []struct {
-		GroupID          int      `json:"group_id"`
-		GroupName        string   `json:"group_name"`
-		GroupFullPath    string   `json:"group_full_path"`
-		GroupAccessLevel int      `json:"group_access_level"`
-		ExpiresAt        *ISOTime `json:"expires_at"`
+	ID                         int                        `json:"id"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Description                string                     `json:"description"`
+	MembershipLock             bool                       `json:"membership_lock"`
+	Visibility                 VisibilityValue            `json:"visibility"`
+	LFSEnabled                 bool                       `json:"lfs_enabled"`
+	AvatarURL                  string                     `json:"avatar_url"`
+	WebURL                     string                     `json:"web_url"`
+	RequestAccessEnabled       bool                       `json:"request_access_enabled"`
+	FullName                   string                     `json:"full_name"`
+	FullPath                   string                     `json:"full_path"`
+	ParentID                   int                        `json:"parent_id"`
+	Projects                   []*Project                 `json:"projects"`
+	Statistics                 *StorageStatistics         `json:"statistics"`
+	CustomAttributes           []*CustomAttribute         `json:"custom_attributes"`
+	ShareWithGroupLock         bool                       `json:"share_with_group_lock"`
+	RequireTwoFactorAuth       bool                       `json:"require_two_factor_authentication"`
+	TwoFactorGracePeriod       int                        `json:"two_factor_grace_period"`
+	ProjectCreationLevel       ProjectCreationLevelValue  `json:"project_creation_level"`
+	AutoDevopsEnabled          bool                       `json:"auto_devops_enabled"`
+	SubGroupCreationLevel      SubGroupCreationLevelValue `json:"subgroup_creation_level"`
+	EmailsDisabled             bool                       `json:"emails_disabled"`
+	MentionsDisabled           bool                       `json:"mentions_disabled"`
+	DefaultBranchProtection    int                        `json:"default_branch_protection"`
+	PreventForkingOutsideGroup bool                       `json:"prevent_forking_outside_group"`
+	RunnersToken               string                     `json:"runners_token"`
+	SharedProjects             []*Project                 `json:"shared_projects"`
+	SharedWithGroups           []struct {
+		GroupID          int              `json:"group_id"`
+		GroupName        string           `json:"group_name"`
+		GroupFullPath    string           `json:"group_full_path"`
+		GroupAccessLevel AccessLevelValue `json:"group_access_level"`
+		ExpiresAt        *ISOTime         `json:"expires_at"`
 	} `json:"shared_with_groups"`
 	LDAPCN                         string           `json:"ldap_cn"`
 	LDAPAccess                     AccessLevelValue `json:"ldap_access"`
@@ -75,8 +81,13 @@ type Group struct {
 	CreatedAt                      *time.Time       `json:"created_at"`
 }
 
+// LDAPGroupLink represents a GitLab LDAP group link.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#ldap-group-links
 type LDAPGroupLink struct {
 	CN          string           `json:"cn"`
+	Filter      string           `json:"filter"`
 	GroupAccess AccessLevelValue `json:"group_access"`
 	Provider    string           `json:"provider"`
 }
@@ -163,6 +174,8 @@ type CreateGroupOptions struct {
 	ParentID                       *int                        `url:"parent_id,omitempty" json:"parent_id,omitempty"`
 	SharedRunnersMinutesLimit      *int                        `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
 	ExtraSharedRunnersMinutesLimit *int                        `url:"extra_shared_runners_minutes_limit,omitempty" json:"extra_shared_runners_minutes_limit,omitempty"`
+	DefaultBranchProtection        *int                        `url:"default_branch_protection,omitempty" json:"default_branch_protection,omitempty"`
+	PreventForkingOutsideGroup     *bool                       `url:"prevent_forking_outside_group,omitempty" json:"prevent_forking_outside_group,omitempty"`
 }
 
 // CreateGroup creates a new project group. Available only for users who can
@@ -184,12 +197,12 @@ func (s *GroupsService) CreateGroup(opt *CreateGroupOptions, options ...RequestO
 	return g, resp, err
 }
 
-// TransferGroup transfers a project to the Group namespace. Available only
-// for admin.
+// TransferProjectToGroup transfers a project to the Group namespace.
+// Available only for admin.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/groups.html#transfer-project-to-group
-func (s *GroupsService) TransferGroup(gid interface{}, pid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
+func (s *GroupsService) TransferProjectToGroup(gid interface{}, pid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
 		return nil, nil, err
@@ -214,6 +227,40 @@ func (s *GroupsService) TransferGroup(gid interface{}, pid interface{}, options
 	return g, resp, err
 }
 
+// TransferGroupOptions represents the available TransferGroup() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#transfer-a-group-to-a-new-parent-group--turn-a-subgroup-to-a-top-level-group
+type TransferGroupOptions struct {
+	GroupID *int `url:"group_id,omitempty" json:"group_id,omitempty"`
+}
+
+// TransferGroup transfers a group to a new parent group. When no GroupID is
+// given, the group is turned into a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#transfer-a-group-to-a-new-parent-group--turn-a-subgroup-to-a-top-level-group
+func (s *GroupsService) TransferGroup(gid interface{}, opt *TransferGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/transfer", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(Group)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
 // UpdateGroupOptions represents the set of available options to update a Group;
 // as of today these are exactly the same available when creating a new Group.
 //
@@ -245,17 +292,113 @@ func (s *GroupsService) UpdateGroup(gid interface{}, opt *UpdateGroupOptions, op
 	return g, resp, err
 }
 
-// DeleteGroup removes group with all projects inside.
+// UploadAvatar uploads a group avatar.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#upload-a-group-avatar
+func (s *GroupsService) UploadAvatar(gid interface{}, avatar io.Reader, filename string, options ...RequestOptionFunc) (*Group, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s", pathEscape(group))
+
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+
+	fw, err := w.CreateFormFile("avatar", filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = io.Copy(fw, avatar)
+	if err != nil {
+		return nil, nil, err
+	}
+	w.Close()
+
+	req, err := s.client.NewRequest("", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Body = ioutil.NopCloser(b)
+	req.ContentLength = int64(b.Len())
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Method = "PUT"
+
+	g := new(Group)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// RemoveAvatar removes the avatar of a group by sending an empty avatar.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#upload-a-group-avatar
+func (s *GroupsService) RemoveAvatar(gid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s", pathEscape(group))
+
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+
+	err = w.WriteField("avatar", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	w.Close()
+
+	req, err := s.client.NewRequest("", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Body = ioutil.NopCloser(b)
+	req.ContentLength = int64(b.Len())
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Method = "PUT"
+
+	g := new(Group)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// DeleteGroupOptions represents the available DeleteGroup() options.
+//
+// PermanentlyRemove and FullPath are only used on instances with delayed
+// group deletion, to immediately remove a group already marked for deletion.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#remove-group
+type DeleteGroupOptions struct {
+	PermanentlyRemove *bool   `url:"permanently_remove,omitempty" json:"permanently_remove,omitempty"`
+	FullPath          *string `url:"full_path,omitempty" json:"full_path,omitempty"`
+}
+
+// DeleteGroup removes group with all projects inside. On instances with
+// delayed group deletion the group is only marked for deletion, unless
+// PermanentlyRemove is set together with the group's FullPath.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#remove-group
-func (s *GroupsService) DeleteGroup(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
+func (s *GroupsService) DeleteGroup(gid interface{}, opt *DeleteGroupOptions, options ...RequestOptionFunc) (*Response, error) {
 	group, err := parseID(gid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("groups/%s", pathEscape(group))
 
-	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	req, err := s.client.NewRequest("DELETE", u, opt, options)
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +406,30 @@ func (s *GroupsService) DeleteGroup(gid interface{}, options ...RequestOptionFun
 	return s.client.Do(req, nil)
 }
 
+// RestoreGroup restores a group that is marked for deletion.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#restore-group-marked-for-deletion
+func (s *GroupsService) RestoreGroup(gid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/restore", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(Group)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
 // SearchGroup get all groups that match your string in their name or path.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#search-for-group
@@ -293,22 +460,25 @@ func (s *GroupsService) SearchGroup(query string, options ...RequestOptionFunc)
 // https://docs.gitlab.com/ce/api/groups.html#list-a-group-39-s-projects
 type ListGroupProjectsOptions struct {
 	ListOptions
-	Archived                 *bool            `url:"archived,omitempty" json:"archived,omitempty"`
-	Visibility               *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
-	OrderBy                  *string          `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort                     *string          `url:"sort,omitempty" json:"sort,omitempty"`
-	Search                   *string          `url:"search,omitempty" json:"search,omitempty"`
-	Simple                   *bool            `url:"simple,omitempty" json:"simple,omitempty"`
-	Owned                    *bool            `url:"owned,omitempty" json:"owned,omitempty"`
-	Starred                  *bool            `url:"starred,omitempty" json:"starred,omitempty"`
-	WithIssuesEnabled        *bool            `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
-	WithMergeRequestsEnabled *bool            `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
-	WithShared               *bool            `url:"with_shared,omitempty" json:"with_shared,omitempty"`
-	IncludeSubgroups         *bool            `url:"include_subgroups,omitempty" json:"include_subgroups,omitempty"`
-	WithCustomAttributes     *bool            `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
-}
-
-// ListGroupProjects get a list of group projects
+	Archived                 *bool             `url:"archived,omitempty" json:"archived,omitempty"`
+	Visibility               *VisibilityValue  `url:"visibility,omitempty" json:"visibility,omitempty"`
+	OrderBy                  *string           `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                     *string           `url:"sort,omitempty" json:"sort,omitempty"`
+	Search                   *string           `url:"search,omitempty" json:"search,omitempty"`
+	Simple                   *bool             `url:"simple,omitempty" json:"simple,omitempty"`
+	Owned                    *bool             `url:"owned,omitempty" json:"owned,omitempty"`
+	Starred                  *bool             `url:"starred,omitempty" json:"starred,omitempty"`
+	WithIssuesEnabled        *bool             `url:"with_issues_enabled,omitempty" json:"with_issues_enabled,omitempty"`
+	WithMergeRequestsEnabled *bool             `url:"with_merge_requests_enabled,omitempty" json:"with_merge_requests_enabled,omitempty"`
+	WithShared               *bool             `url:"with_shared,omitempty" json:"with_shared,omitempty"`
+	IncludeSubgroups         *bool             `url:"include_subgroups,omitempty" json:"include_subgroups,omitempty"`
+	MinAccessLevel           *AccessLevelValue `url:"min_access_level,omitempty" json:"min_access_level,omitempty"`
+	WithCustomAttributes     *bool             `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
+}
+
+// ListGroupProjects get a list of group projects. Setting IncludeSubgroups
+// lists the projects of all subgroups as well, within a single paginated
+// listing.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/groups.html#list-a-group-39-s-projects
@@ -392,13 +562,16 @@ func (s *GroupsService) ListGroupLDAPLinks(gid interface{}, options ...RequestOp
 }
 
 // AddGroupLDAPLinkOptions represents the available AddGroupLDAPLink() options.
+// Either CN or Filter must be set. The Provider is required when multiple LDAP
+// servers are configured.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#add-ldap-group-link-starter
 type AddGroupLDAPLinkOptions struct {
-	CN          *string `url:"cn,omitempty" json:"cn,omitempty"`
-	GroupAccess *int    `url:"group_access,omitempty" json:"group_access,omitempty"`
-	Provider    *string `url:"provider,omitempty" json:"provider,omitempty"`
+	CN          *string           `url:"cn,omitempty" json:"cn,omitempty"`
+	Filter      *string           `url:"filter,omitempty" json:"filter,omitempty"`
+	GroupAccess *AccessLevelValue `url:"group_access,omitempty" json:"group_access,omitempty"`
+	Provider    *string           `url:"provider,omitempty" json:"provider,omitempty"`
 }
 
 // AddGroupLDAPLink creates a new group LDAP link. Available only for users who
@@ -472,6 +645,112 @@ func (s *GroupsService) DeleteGroupLDAPLinkForProvider(gid interface{}, provider
 	return s.client.Do(req, nil)
 }
 
+// DeleteGroupLDAPLinkWithCNOrFilterOptions represents the available
+// DeleteGroupLDAPLinkWithCNOrFilter() options. Either CN or Filter must be set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link
+type DeleteGroupLDAPLinkWithCNOrFilterOptions struct {
+	CN       *string `url:"cn,omitempty" json:"cn,omitempty"`
+	Filter   *string `url:"filter,omitempty" json:"filter,omitempty"`
+	Provider *string `url:"provider,omitempty" json:"provider,omitempty"`
+}
+
+// DeleteGroupLDAPLinkWithCNOrFilter deletes a group LDAP link identified by
+// either its CN or its filter. Available only for users who can edit groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link
+func (s *GroupsService) DeleteGroupLDAPLinkWithCNOrFilter(gid interface{}, opts *DeleteGroupLDAPLinkWithCNOrFilterOptions, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/ldap_group_links", pathEscape(group))
+
+	req, err := s.client.NewRequest("DELETE", u, opts, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// SyncGroupLDAP syncs the group with its linked LDAP groups. Only available
+// to group owners and administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#sync-group-with-ldap
+func (s *GroupsService) SyncGroupLDAP(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/ldap_sync", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ShareGroupWithGroupOptions represents the available ShareGroupWithGroup()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#share-groups-with-groups
+type ShareGroupWithGroupOptions struct {
+	GroupID     *int              `url:"group_id,omitempty" json:"group_id,omitempty"`
+	GroupAccess *AccessLevelValue `url:"group_access,omitempty" json:"group_access,omitempty"`
+	ExpiresAt   *ISOTime          `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// ShareGroupWithGroup shares a group with another group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#create-a-link-to-share-a-group-with-another-group
+func (s *GroupsService) ShareGroupWithGroup(gid interface{}, opt *ShareGroupWithGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/share", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(Group)
+	resp, err := s.client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, err
+}
+
+// UnshareGroupFromGroup unshares a group from another group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#delete-link-sharing-group-with-another-group
+func (s *GroupsService) UnshareGroupFromGroup(gid interface{}, groupID int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/share/%d", pathEscape(group), groupID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // GroupPushRules represents a group push rule.
 //
 // GitLab API docs:
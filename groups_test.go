@@ -1,10 +1,13 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestListGroups(t *testing.T) {
@@ -75,7 +78,56 @@ func TestCreateGroup(t *testing.T) {
 	}
 }
 
-func TestTransferGroup(t *testing.T) {
+func TestCreateSubGroupWithPolicy(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"name":"team-a","path":"team-a","description":"Team A","visibility":"private","require_two_factor_authentication":true,"two_factor_grace_period":48,"project_creation_level":"maintainer","subgroup_creation_level":"owner","emails_disabled":false,"lfs_enabled":true,"request_access_enabled":false,"parent_id":7,"default_branch_protection":2,"prevent_forking_outside_group":true}`)
+			fmt.Fprint(w, `{"id":12,"name":"team-a","path":"team-a","parent_id":7,"visibility":"private","require_two_factor_authentication":true,"two_factor_grace_period":48,"default_branch_protection":2,"prevent_forking_outside_group":true}`)
+		})
+
+	opt := &CreateGroupOptions{
+		Name:                       String("team-a"),
+		Path:                       String("team-a"),
+		Description:                String("Team A"),
+		Visibility:                 Visibility(PrivateVisibility),
+		RequireTwoFactorAuth:       Bool(true),
+		TwoFactorGracePeriod:       Int(48),
+		ProjectCreationLevel:       ProjectCreationLevel(MaintainerProjectCreation),
+		SubGroupCreationLevel:      SubGroupCreationLevel(OwnerSubGroupCreationLevelValue),
+		EmailsDisabled:             Bool(false),
+		LFSEnabled:                 Bool(true),
+		RequestAccessEnabled:       Bool(false),
+		ParentID:                   Int(7),
+		DefaultBranchProtection:    Int(2),
+		PreventForkingOutsideGroup: Bool(true),
+	}
+
+	group, _, err := client.Groups.CreateGroup(opt)
+	if err != nil {
+		t.Fatalf("Groups.CreateGroup returned error: %v", err)
+	}
+
+	want := &Group{
+		ID:                         12,
+		Name:                       "team-a",
+		Path:                       "team-a",
+		ParentID:                   7,
+		Visibility:                 PrivateVisibility,
+		RequireTwoFactorAuth:       true,
+		TwoFactorGracePeriod:       48,
+		DefaultBranchProtection:    2,
+		PreventForkingOutsideGroup: true,
+	}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.CreateGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestTransferProjectToGroup(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
 
@@ -85,16 +137,85 @@ func TestTransferGroup(t *testing.T) {
 			fmt.Fprintf(w, `{"id": 1}`)
 		})
 
-	group, _, err := client.Groups.TransferGroup(1, 2)
+	group, _, err := client.Groups.TransferProjectToGroup(1, 2)
 	if err != nil {
-		t.Errorf("Groups.TransferGroup returned error: %v", err)
+		t.Errorf("Groups.TransferProjectToGroup returned error: %v", err)
 	}
 
 	want := &Group{ID: 1}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Groups.TransferProjectToGroup returned %+v, want %+v", group, want)
+	}
+
+}
+
+func TestTransferProjectToGroupByPath(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testURL(t, r, "/api/v4/groups/org%2Fteam-b/projects/org%2Fteam-a%2Fapi")
+			fmt.Fprintf(w, `{"id": 4, "full_path": "org/team-b"}`)
+		})
+
+	group, _, err := client.Groups.TransferProjectToGroup("org/team-b", "org/team-a/api")
+	if err != nil {
+		t.Fatalf("Groups.TransferProjectToGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 4, FullPath: "org/team-b"}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Groups.TransferProjectToGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestTransferGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/transfer",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"group_id":7}`)
+			fmt.Fprintf(w, `{"id": 5, "parent_id": 7}`)
+		})
+
+	group, _, err := client.Groups.TransferGroup(5, &TransferGroupOptions{GroupID: Int(7)})
+	if err != nil {
+		t.Fatalf("Groups.TransferGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 5, ParentID: 7}
 	if !reflect.DeepEqual(group, want) {
 		t.Errorf("Groups.TransferGroup returned %+v, want %+v", group, want)
 	}
+}
 
+func TestTransferGroupNameCollision(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/transfer",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message":"Transfer failed: The parent group already has a subgroup or a project with the same path."}`)
+		})
+
+	_, resp, err := client.Groups.TransferGroup(5, &TransferGroupOptions{GroupID: Int(7)})
+	if err == nil {
+		t.Fatal("Groups.TransferGroup expected an error")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Groups.TransferGroup returned status %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	want := "{message: Transfer failed: The parent group already has a subgroup or a project with the same path.}"
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Message != want {
+		t.Errorf("Groups.TransferGroup returned error %v, want message %q", err, want)
+	}
 }
 
 func TestDeleteGroup(t *testing.T) {
@@ -107,7 +228,7 @@ func TestDeleteGroup(t *testing.T) {
 			w.WriteHeader(http.StatusAccepted)
 		})
 
-	resp, err := client.Groups.DeleteGroup(1)
+	resp, err := client.Groups.DeleteGroup(1, nil)
 	if err != nil {
 		t.Errorf("Groups.DeleteGroup returned error: %v", err)
 	}
@@ -119,6 +240,68 @@ func TestDeleteGroup(t *testing.T) {
 	}
 }
 
+func TestDeleteGroupPermanently(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			testURL(t, r, "/api/v4/groups/1?full_path=parent%2Fgroup&permanently_remove=true")
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+	opt := &DeleteGroupOptions{
+		PermanentlyRemove: Bool(true),
+		FullPath:          String("parent/group"),
+	}
+	_, err := client.Groups.DeleteGroup(1, opt)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroup returned error: %v", err)
+	}
+}
+
+func TestRestoreGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/restore",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			fmt.Fprint(w, `{"id": 1, "name": "g", "marked_for_deletion_on": null}`)
+		})
+
+	group, _, err := client.Groups.RestoreGroup(1)
+	if err != nil {
+		t.Fatalf("Groups.RestoreGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, Name: "g"}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.RestoreGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestGetGroupMarkedForDeletion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprint(w, `{"id": 1, "marked_for_deletion_on": "2020-10-05"}`)
+		})
+
+	group, _, err := client.Groups.GetGroup(1)
+	if err != nil {
+		t.Fatalf("Groups.GetGroup returned error: %v", err)
+	}
+
+	if group.MarkedForDeletionOn == nil || group.MarkedForDeletionOn.String() != "2020-10-05" {
+		t.Errorf("Groups.GetGroup returned marked_for_deletion_on %v, want 2020-10-05", group.MarkedForDeletionOn)
+	}
+}
+
 func TestSearchGroup(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -161,6 +344,87 @@ func TestUpdateGroup(t *testing.T) {
 	}
 }
 
+func TestUpdateGroupPartial(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"mentions_disabled":true}`)
+			fmt.Fprint(w, `{"id": 1, "mentions_disabled": true}`)
+		})
+
+	group, _, err := client.Groups.UpdateGroup(1, &UpdateGroupOptions{MentionsDisabled: Bool(true)})
+	if err != nil {
+		t.Fatalf("Groups.UpdateGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, MentionsDisabled: true}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestUploadGroupAvatar(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			if !strings.Contains(r.Header.Get("Content-Type"), "multipart/form-data;") {
+				t.Fatalf("Groups.UploadAvatar request content-type %+v want multipart/form-data;", r.Header.Get("Content-Type"))
+			}
+			f, h, err := r.FormFile("avatar")
+			if err != nil {
+				t.Fatalf("Groups.UploadAvatar request has no avatar file: %v", err)
+			}
+			defer f.Close()
+			if h.Filename != "logo.png" {
+				t.Errorf("Groups.UploadAvatar request filename %q, want %q", h.Filename, "logo.png")
+			}
+			fmt.Fprint(w, `{"id": 1, "avatar_url": "https://gitlab.example.com/uploads/-/system/group/avatar/1/logo.png"}`)
+		})
+
+	group, _, err := client.Groups.UploadAvatar(1, bytes.NewBufferString("fake png"), "logo.png")
+	if err != nil {
+		t.Fatalf("Groups.UploadAvatar returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, AvatarURL: "https://gitlab.example.com/uploads/-/system/group/avatar/1/logo.png"}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UploadAvatar returned %+v, want %+v", group, want)
+	}
+}
+
+func TestRemoveGroupAvatar(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("Groups.RemoveAvatar request is not multipart: %v", err)
+			}
+			if v, ok := r.MultipartForm.Value["avatar"]; !ok || len(v) != 1 || v[0] != "" {
+				t.Errorf("Groups.RemoveAvatar request avatar value %v, want empty", v)
+			}
+			fmt.Fprint(w, `{"id": 1, "avatar_url": null}`)
+		})
+
+	group, _, err := client.Groups.RemoveAvatar(1)
+	if err != nil {
+		t.Fatalf("Groups.RemoveAvatar returned error: %v", err)
+	}
+
+	want := &Group{ID: 1}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.RemoveAvatar returned %+v, want %+v", group, want)
+	}
+}
+
 func TestListGroupProjects(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -183,6 +447,66 @@ func TestListGroupProjects(t *testing.T) {
 	}
 }
 
+func TestListGroupProjectsWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/22/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/groups/22/projects?archived=false&include_subgroups=true&min_access_level=30&order_by=path&page=2&per_page=50&simple=true&sort=asc&with_shared=false")
+			fmt.Fprint(w, `[{
+				"id": 4,
+				"description": null,
+				"name": "Diaspora Client",
+				"name_with_namespace": "Diaspora / Diaspora Client",
+				"path": "diaspora-client",
+				"path_with_namespace": "diaspora/diaspora-client",
+				"created_at": "2013-09-30T13:46:02Z",
+				"default_branch": "master",
+				"tag_list": ["example"],
+				"ssh_url_to_repo": "git@gitlab.example.com:diaspora/diaspora-client.git",
+				"http_url_to_repo": "https://gitlab.example.com/diaspora/diaspora-client.git",
+				"web_url": "https://gitlab.example.com/diaspora/diaspora-client",
+				"readme_url": null,
+				"avatar_url": null,
+				"forks_count": 0,
+				"star_count": 0,
+				"last_activity_at": "2013-09-30T13:46:02Z",
+				"namespace": {
+					"id": 3,
+					"name": "Diaspora",
+					"path": "diaspora",
+					"kind": "group",
+					"full_path": "diaspora"
+				}
+			}]`)
+		})
+
+	opt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{Page: 2, PerPage: 50},
+		Archived:         Bool(false),
+		OrderBy:          String("path"),
+		Sort:             String("asc"),
+		Simple:           Bool(true),
+		WithShared:       Bool(false),
+		IncludeSubgroups: Bool(true),
+		MinAccessLevel:   AccessLevel(DeveloperPermissions),
+	}
+	projects, _, err := client.Groups.ListGroupProjects(22, opt)
+	if err != nil {
+		t.Fatalf("Groups.ListGroupProjects returned error: %v", err)
+	}
+
+	if len(projects) != 1 {
+		t.Fatalf("Groups.ListGroupProjects returned %d projects, want 1", len(projects))
+	}
+	p := projects[0]
+	if p.ID != 4 || p.PathWithNamespace != "diaspora/diaspora-client" || p.Namespace == nil || p.Namespace.FullPath != "diaspora" {
+		t.Errorf("Groups.ListGroupProjects returned %+v", p)
+	}
+}
+
 func TestListSubgroups(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -264,7 +588,7 @@ func TestAddGroupLDAPLink(t *testing.T) {
 
 	opt := &AddGroupLDAPLinkOptions{
 		CN:          String("gitlab_group_example_30"),
-		GroupAccess: Int(30),
+		GroupAccess: AccessLevel(DeveloperPermissions),
 		Provider:    String("example_ldap_provider"),
 	}
 
@@ -282,3 +606,131 @@ func TestAddGroupLDAPLink(t *testing.T) {
 		t.Errorf("Groups.AddGroupLDAPLink returned %+v, want %+v", link, want)
 	}
 }
+
+func TestAddGroupLDAPLinkWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ldap_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"filter":"(memberOf=cn=developers,ou=groups,dc=example,dc=com)","group_access":40,"provider":"ldapmain"}`)
+			fmt.Fprint(w, `{"filter":"(memberOf=cn=developers,ou=groups,dc=example,dc=com)","group_access":40,"provider":"ldapmain"}`)
+		})
+
+	opt := &AddGroupLDAPLinkOptions{
+		Filter:      String("(memberOf=cn=developers,ou=groups,dc=example,dc=com)"),
+		GroupAccess: AccessLevel(MaintainerPermissions),
+		Provider:    String("ldapmain"),
+	}
+
+	link, _, err := client.Groups.AddGroupLDAPLink(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.AddGroupLDAPLink returned error: %v", err)
+	}
+
+	want := &LDAPGroupLink{
+		Filter:      "(memberOf=cn=developers,ou=groups,dc=example,dc=com)",
+		GroupAccess: MaintainerPermissions,
+		Provider:    "ldapmain",
+	}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("Groups.AddGroupLDAPLink returned %+v, want %+v", link, want)
+	}
+}
+
+func TestDeleteGroupLDAPLinkWithCNOrFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ldap_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			testURL(t, r, "/api/v4/groups/1/ldap_group_links?cn=gitlab_group_example_30&provider=ldapmain")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	opts := &DeleteGroupLDAPLinkWithCNOrFilterOptions{
+		CN:       String("gitlab_group_example_30"),
+		Provider: String("ldapmain"),
+	}
+
+	_, err := client.Groups.DeleteGroupLDAPLinkWithCNOrFilter(1, opts)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroupLDAPLinkWithCNOrFilter returned error: %v", err)
+	}
+}
+
+func TestSyncGroupLDAP(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ldap_sync",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+	resp, err := client.Groups.SyncGroupLDAP(1)
+	if err != nil {
+		t.Fatalf("Groups.SyncGroupLDAP returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Groups.SyncGroupLDAP returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestShareGroupWithGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/share",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"group_id":2,"group_access":20,"expires_at":"2021-03-31"}`)
+			fmt.Fprint(w, `{"id": 1, "shared_with_groups": [{"group_id": 2, "group_name": "platform", "group_full_path": "platform", "group_access_level": 20, "expires_at": "2021-03-31"}]}`)
+		})
+
+	expiresAt := ISOTime(time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC))
+	opt := &ShareGroupWithGroupOptions{
+		GroupID:     Int(2),
+		GroupAccess: AccessLevel(ReporterPermissions),
+		ExpiresAt:   &expiresAt,
+	}
+	group, _, err := client.Groups.ShareGroupWithGroup(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ShareGroupWithGroup returned error: %v", err)
+	}
+
+	if len(group.SharedWithGroups) != 1 {
+		t.Fatalf("Groups.ShareGroupWithGroup returned %d shared groups, want 1", len(group.SharedWithGroups))
+	}
+	shared := group.SharedWithGroups[0]
+	if shared.GroupID != 2 || shared.GroupAccessLevel != ReporterPermissions {
+		t.Errorf("Groups.ShareGroupWithGroup returned shared group %+v", shared)
+	}
+	if shared.ExpiresAt == nil || shared.ExpiresAt.String() != "2021-03-31" {
+		t.Errorf("Groups.ShareGroupWithGroup returned expires_at %v, want 2021-03-31", shared.ExpiresAt)
+	}
+}
+
+func TestUnshareGroupFromGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/share/2",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	resp, err := client.Groups.UnshareGroupFromGroup(1, 2)
+	if err != nil {
+		t.Fatalf("Groups.UnshareGroupFromGroup returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Groups.UnshareGroupFromGroup returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
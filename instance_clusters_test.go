@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInstanceListClusters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":9,"name":"cluster-1","managed":true,"enabled":true,"environment_scope":"*","cluster_type":"instance_type"}]`)
+	})
+
+	clusters, _, err := client.InstanceCluster.ListClusters()
+	if err != nil {
+		t.Fatalf("InstanceClusters.ListClusters returned error: %v", err)
+	}
+
+	want := []*InstanceCluster{{
+		ID:               9,
+		Name:             "cluster-1",
+		Enabled:          true,
+		Managed:          true,
+		EnvironmentScope: "*",
+		ClusterType:      "instance_type",
+	}}
+	if !reflect.DeepEqual(want, clusters) {
+		t.Errorf("InstanceClusters.ListClusters returned %+v, want %+v", clusters, want)
+	}
+}
+
+func TestInstanceAddCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"cluster-3","environment_scope":"production","platform_kubernetes_attributes":{"api_url":"https://k8s.example.com","token":"secret"}}`)
+		fmt.Fprint(w, `{"id":11,"name":"cluster-3","environment_scope":"production","cluster_type":"instance_type"}`)
+	})
+
+	opt := &AddClusterOptions{
+		Name:             String("cluster-3"),
+		EnvironmentScope: String("production"),
+		PlatformKubernetes: &AddPlatformKubernetesOptions{
+			APIURL: String("https://k8s.example.com"),
+			Token:  String("secret"),
+		},
+	}
+	cluster, _, err := client.InstanceCluster.AddCluster(opt)
+	if err != nil {
+		t.Fatalf("InstanceClusters.AddCluster returned error: %v", err)
+	}
+
+	want := &InstanceCluster{ID: 11, Name: "cluster-3", EnvironmentScope: "production", ClusterType: "instance_type"}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("InstanceClusters.AddCluster returned %+v, want %+v", cluster, want)
+	}
+}
+
+func TestInstanceEditCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"new-cluster-name","managed":false}`)
+		fmt.Fprint(w, `{"id":11,"name":"new-cluster-name","managed":false}`)
+	})
+
+	opt := &EditClusterOptions{Name: String("new-cluster-name"), Managed: Bool(false)}
+	cluster, _, err := client.InstanceCluster.EditCluster(11, opt)
+	if err != nil {
+		t.Fatalf("InstanceClusters.EditCluster returned error: %v", err)
+	}
+
+	want := &InstanceCluster{ID: 11, Name: "new-cluster-name"}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("InstanceClusters.EditCluster returned %+v, want %+v", cluster, want)
+	}
+}
+
+func TestInstanceDeleteCluster(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.InstanceCluster.DeleteCluster(11)
+	if err != nil {
+		t.Fatalf("InstanceClusters.DeleteCluster returned error: %v", err)
+	}
+}
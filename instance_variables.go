@@ -40,6 +40,7 @@ type InstanceVariable struct {
 	VariableType VariableTypeValue `json:"variable_type"`
 	Protected    bool              `json:"protected"`
 	Masked       bool              `json:"masked"`
+	Raw          bool              `json:"raw"`
 }
 
 func (v InstanceVariable) String() string {
@@ -106,6 +107,7 @@ type CreateInstanceVariableOptions struct {
 	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
 	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw          *bool              `url:"raw,omitempty" json:"raw,omitempty"`
 }
 
 // CreateVariable creates a new instance level CI variable.
@@ -139,10 +141,10 @@ type UpdateInstanceVariableOptions struct {
 	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
 	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw          *bool              `url:"raw,omitempty" json:"raw,omitempty"`
 }
 
-// UpdateVariable updates the position of an existing
-// instance level CI variable.
+// UpdateVariable updates an existing instance level CI variable.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#update-instance-variable
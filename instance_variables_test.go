@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListInstanceVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key":"HTTP_PROXY","value":"http://proxy:3128","variable_type":"env_var","protected":false,"masked":false,"raw":true}]`)
+	})
+
+	variables, _, err := client.InstanceVariables.ListVariables(nil)
+	if err != nil {
+		t.Errorf("InstanceVariables.ListVariables returned error: %v", err)
+	}
+
+	want := []*InstanceVariable{{
+		Key:          "HTTP_PROXY",
+		Value:        "http://proxy:3128",
+		VariableType: EnvVariableType,
+		Raw:          true,
+	}}
+	if !reflect.DeepEqual(want, variables) {
+		t.Errorf("InstanceVariables.ListVariables returned %+v, want %+v", variables, want)
+	}
+}
+
+func TestCreateInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"REGISTRY_MIRROR","value":"https://mirror.example.com","protected":true}`)
+		fmt.Fprint(w, `{"key":"REGISTRY_MIRROR","value":"https://mirror.example.com","variable_type":"env_var","protected":true}`)
+	})
+
+	opt := &CreateInstanceVariableOptions{
+		Key:       String("REGISTRY_MIRROR"),
+		Value:     String("https://mirror.example.com"),
+		Protected: Bool(true),
+	}
+
+	variable, _, err := client.InstanceVariables.CreateVariable(opt)
+	if err != nil {
+		t.Errorf("InstanceVariables.CreateVariable returned error: %v", err)
+	}
+
+	want := &InstanceVariable{
+		Key:          "REGISTRY_MIRROR",
+		Value:        "https://mirror.example.com",
+		VariableType: EnvVariableType,
+		Protected:    true,
+	}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("InstanceVariables.CreateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestGetInstanceVariableForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/HTTP_PROXY", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, _, err := client.InstanceVariables.GetVariable("HTTP_PROXY")
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("InstanceVariables.GetVariable returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("InstanceVariables.GetVariable returned status %d, want %d", errResp.Response.StatusCode, http.StatusForbidden)
+	}
+}
+
+func TestRemoveInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/HTTP_PROXY", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.InstanceVariables.RemoveVariable("HTTP_PROXY")
+	if err != nil {
+		t.Errorf("InstanceVariables.RemoveVariable returned error: %v", err)
+	}
+}
@@ -79,7 +79,8 @@ type IssueLinks struct {
 	Project    string `json:"project"`
 }
 
-// Issue represents a GitLab issue.
+// Issue represents a GitLab issue. The Subscribed field is only set when
+// getting a single issue.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html
 type Issue struct {
@@ -218,6 +219,7 @@ type ListIssuesOptions struct {
 	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed         *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter       *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
@@ -268,11 +270,13 @@ type ListGroupIssuesOptions struct {
 	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed         *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 	In                 *string    `url:"in,omitempty" json:"in,omitempty"`
 	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter       *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
 	UpdatedBefore      *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Confidential       *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
 }
 
 // ListGroupIssues gets a list of group issues. This function accepts
@@ -323,6 +327,7 @@ type ListProjectIssuesOptions struct {
 	OrderBy            *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort               *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search             *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed         *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 	In                 *string    `url:"in,omitempty" json:"in,omitempty"`
 	CreatedAfter       *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore      *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
@@ -298,6 +298,37 @@ func TestCreateIssue(t *testing.T) {
 	}
 }
 
+func TestCreateConfidentialIssue(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"Security issue","confidential":true}`)
+		fmt.Fprint(w, `{"id":1, "title" : "Security issue", "confidential": true}`)
+	})
+
+	createIssueOptions := &CreateIssueOptions{
+		Title:        String("Security issue"),
+		Confidential: Bool(true),
+	}
+
+	issue, _, err := client.Issues.CreateIssue("1", createIssueOptions)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := &Issue{
+		ID:           1,
+		Title:        "Security issue",
+		Confidential: true,
+	}
+
+	if !reflect.DeepEqual(want, issue) {
+		t.Errorf("Issues.CreateIssue returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestUpdateIssue(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -573,3 +604,24 @@ func TestGetIssueParticipants(t *testing.T) {
 		t.Errorf("Issues.GetIssueParticipants returned %+v, want %+v", issueParticipants, want)
 	}
 }
+
+func TestListIssuesSubscribed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/issues?subscribed=true")
+		fmt.Fprint(w, `[{"id":1,"title":"Watched issue"}]`)
+	})
+
+	issues, _, err := client.Issues.ListIssues(&ListIssuesOptions{Subscribed: Bool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Title: "Watched issue"}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListIssues returned %+v, want %+v", issues, want)
+	}
+}
@@ -76,10 +76,34 @@ type Job struct {
 	User   *User  `json:"user"`
 }
 
+// Bridge represents a pipeline bridge.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/jobs.html#list-pipeline-bridges
+type Bridge struct {
+	Commit             *Commit       `json:"commit"`
+	Coverage           float64       `json:"coverage"`
+	AllowFailure       bool          `json:"allow_failure"`
+	CreatedAt          *time.Time    `json:"created_at"`
+	StartedAt          *time.Time    `json:"started_at"`
+	FinishedAt         *time.Time    `json:"finished_at"`
+	Duration           float64       `json:"duration"`
+	ID                 int           `json:"id"`
+	Name               string        `json:"name"`
+	Pipeline           PipelineInfo  `json:"pipeline"`
+	Ref                string        `json:"ref"`
+	Stage              string        `json:"stage"`
+	Status             string        `json:"status"`
+	Tag                bool          `json:"tag"`
+	WebURL             string        `json:"web_url"`
+	User               *User         `json:"user"`
+	DownstreamPipeline *PipelineInfo `json:"downstream_pipeline"`
+}
+
 // ListJobsOptions are options for two list apis
 type ListJobsOptions struct {
 	ListOptions
-	Scope []BuildStateValue `url:"scope[],omitempty" json:"scope,omitempty"`
+	Scope          []BuildStateValue `url:"scope[],omitempty" json:"scope,omitempty"`
+	IncludeRetried *bool             `url:"include_retried,omitempty" json:"include_retried,omitempty"`
 }
 
 // ListProjectJobs gets a list of jobs in a project.
@@ -136,6 +160,32 @@ func (s *JobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *Li
 	return jobs, resp, err
 }
 
+// ListPipelineBridges gets a list of bridges for specific pipeline in a
+// project. Bridges are the trigger jobs of downstream pipelines.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#list-pipeline-bridges
+func (s *JobsService) ListPipelineBridges(pid interface{}, pipelineID int, opts *ListJobsOptions, options ...RequestOptionFunc) ([]*Bridge, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/bridges", pathEscape(project), pipelineID)
+
+	req, err := s.client.NewRequest("GET", u, opts, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bridges []*Bridge
+	resp, err := s.client.Do(req, &bridges)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bridges, resp, err
+}
+
 // GetJob gets a single job of a project.
 //
 // GitLab API docs:
@@ -186,6 +236,26 @@ func (s *JobsService) GetJobArtifacts(pid interface{}, jobID int, options ...Req
 	return artifactsBuf, resp, err
 }
 
+// StreamJobArtifacts streams the artifacts archive of a job to the provided
+// io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#get-job-artifacts
+func (s *JobsService) StreamJobArtifacts(pid interface{}, jobID int, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", pathEscape(project), jobID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // DownloadArtifactsFileOptions represents the available DownloadArtifactsFile()
 // options.
 //
@@ -205,7 +275,7 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), refName)
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), pathEscape(refName))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -221,6 +291,26 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 	return artifactsBuf, resp, err
 }
 
+// StreamArtifactsFile streams the artifacts archive from the given reference
+// name and job to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-the-artifacts-file
+func (s *JobsService) StreamArtifactsFile(pid interface{}, refName string, w io.Writer, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), pathEscape(refName))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // DownloadSingleArtifactsFile download a file from the artifacts from the
 // given reference name and job provided the job finished successfully.
 // Only a single file is going to be extracted from the archive and streamed
@@ -238,10 +328,70 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 		"projects/%s/jobs/%d/artifacts/%s",
 		pathEscape(project),
 		jobID,
-		artifactPath,
+		pathSegmentsEscape(artifactPath),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	artifactBuf := new(bytes.Buffer)
+	resp, err := s.client.Do(req, artifactBuf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return artifactBuf, resp, err
+}
+
+// StreamSingleArtifactsFile streams a single file from the artifacts of the
+// given job to the provided io.Writer, without buffering it in memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file
+func (s *JobsService) StreamSingleArtifactsFile(pid interface{}, jobID int, artifactPath string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf(
+		"projects/%s/jobs/%d/artifacts/%s",
+		pathEscape(project),
+		jobID,
+		pathSegmentsEscape(artifactPath),
 	)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// DownloadSingleArtifactsFileByRefName download a single file from the
+// artifacts of the latest successful job with the given name, for the given
+// reference name. Only a single file is going to be extracted from the archive
+// and streamed to a client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) DownloadSingleArtifactsFileByRefName(pid interface{}, refName string, artifactPath string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (io.Reader, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf(
+		"projects/%s/jobs/artifacts/%s/raw/%s",
+		pathEscape(project),
+		pathEscape(refName),
+		pathSegmentsEscape(artifactPath),
+	)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -255,7 +405,35 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 	return artifactBuf, resp, err
 }
 
-// GetTraceFile gets a trace of a specific job of a project
+// StreamSingleArtifactsFileByRefName streams a single file from the artifacts
+// of the latest successful job with the given name, for the given reference
+// name, to the provided io.Writer, without buffering it in memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) StreamSingleArtifactsFileByRefName(pid interface{}, refName string, artifactPath string, w io.Writer, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf(
+		"projects/%s/jobs/artifacts/%s/raw/%s",
+		pathEscape(project),
+		pathEscape(refName),
+		pathSegmentsEscape(artifactPath),
+	)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// GetTraceFile gets a trace of a specific job of a project. The trace is
+// returned as raw text, which will be empty if the job has not started yet.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#get-a-trace-file
@@ -305,7 +483,8 @@ func (s *JobsService) CancelJob(pid interface{}, jobID int, options ...RequestOp
 	return job, resp, err
 }
 
-// RetryJob retries a single job of a project
+// RetryJob retries a single job of a project. The returned job is a new job
+// with a new ID, so callers should track that ID instead of the original one.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#retry-a-job
@@ -331,7 +510,7 @@ func (s *JobsService) RetryJob(pid interface{}, jobID int, options ...RequestOpt
 }
 
 // EraseJob erases a single job of a project, removes a job
-// artifacts and a job trace.
+// artifacts and a job trace. Only finished jobs can be erased.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#erase-a-job
@@ -382,18 +561,73 @@ func (s *JobsService) KeepArtifacts(pid interface{}, jobID int, options ...Reque
 	return job, resp, err
 }
 
+// DeleteArtifacts delete artifacts of a job
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#delete-artifacts
+func (s *JobsService) DeleteArtifacts(pid interface{}, jobID int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", pathEscape(project), jobID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteProjectArtifacts delete artifacts eligible for deletion in a project
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#delete-project-artifacts
+func (s *JobsService) DeleteProjectArtifacts(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/artifacts", pathEscape(project))
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// JobVariableOptions represents a single job variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+type JobVariableOptions struct {
+	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Value *string `url:"value,omitempty" json:"value,omitempty"`
+}
+
+// PlayJobOptions represents the available PlayJob() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+type PlayJobOptions struct {
+	JobVariablesAttributes []*JobVariableOptions `url:"job_variables_attributes,omitempty" json:"job_variables_attributes,omitempty"`
+}
+
 // PlayJob triggers a manual action to start a job.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#play-a-job
-func (s *JobsService) PlayJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
+func (s *JobsService) PlayJob(pid interface{}, jobID int, opt *PlayJobOptions, options ...RequestOptionFunc) (*Job, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/jobs/%d/play", pathEscape(project), jobID)
 
-	req, err := s.client.NewRequest("POST", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -26,3 +27,485 @@ func TestListPipelineJobs(t *testing.T) {
 		t.Errorf("Jobs.ListPipelineJobs returned %+v, want %+v", jobs, want)
 	}
 }
+
+func TestListPipelineJobsWithScope(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/pipelines/1/jobs?include_retried=true&scope%5B%5D=failed&scope%5B%5D=canceled")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	opts := &ListJobsOptions{
+		Scope:          []BuildStateValue{Failed, Canceled},
+		IncludeRetried: Bool(true),
+	}
+
+	jobs, _, err := client.Jobs.ListPipelineJobs(1, 1, opts)
+	if err != nil {
+		t.Errorf("Jobs.ListPipelineJobs returned error: %v", err)
+	}
+
+	want := []*Job{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(want, jobs) {
+		t.Errorf("Jobs.ListPipelineJobs returned %+v, want %+v", jobs, want)
+	}
+}
+
+func TestListPipelineBridges(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/1/bridges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"trigger_downstream","pipeline":{"id":1},"downstream_pipeline":{"id":5,"status":"running"}}]`)
+	})
+
+	bridges, _, err := client.Jobs.ListPipelineBridges(1, 1, nil)
+	if err != nil {
+		t.Errorf("Jobs.ListPipelineBridges returned error: %v", err)
+	}
+
+	want := []*Bridge{{
+		ID:                 1,
+		Name:               "trigger_downstream",
+		Pipeline:           PipelineInfo{ID: 1},
+		DownstreamPipeline: &PipelineInfo{ID: 5, Status: "running"},
+	}}
+	if !reflect.DeepEqual(want, bridges) {
+		t.Errorf("Jobs.ListPipelineBridges returned %+v, want %+v", bridges, want)
+	}
+}
+
+func TestStreamJobArtifacts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Length", "7")
+		fmt.Fprint(w, "zipdata")
+	})
+
+	var b bytes.Buffer
+	resp, err := client.Jobs.StreamJobArtifacts(1, 5, &b)
+	if err != nil {
+		t.Fatalf("Jobs.StreamJobArtifacts returned error: %v", err)
+	}
+
+	if b.String() != "zipdata" {
+		t.Errorf("Jobs.StreamJobArtifacts wrote %q, want %q", b.String(), "zipdata")
+	}
+	if resp.ContentLength != 7 {
+		t.Errorf("Jobs.StreamJobArtifacts returned content length %d, want %d", resp.ContentLength, 7)
+	}
+}
+
+func TestStreamArtifactsFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/master/download?job=build")
+		fmt.Fprint(w, "zipdata")
+	})
+
+	var b bytes.Buffer
+	opt := &DownloadArtifactsFileOptions{Job: String("build")}
+	_, err := client.Jobs.StreamArtifactsFile(1, "master", &b, opt)
+	if err != nil {
+		t.Fatalf("Jobs.StreamArtifactsFile returned error: %v", err)
+	}
+
+	if b.String() != "zipdata" {
+		t.Errorf("Jobs.StreamArtifactsFile wrote %q, want %q", b.String(), "zipdata")
+	}
+}
+
+func TestStreamArtifactsFileExpired(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Not Found"}`)
+	})
+
+	var b bytes.Buffer
+	opt := &DownloadArtifactsFileOptions{Job: String("build")}
+	_, err := client.Jobs.StreamArtifactsFile(1, "master", &b, opt)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Jobs.StreamArtifactsFile returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Jobs.StreamArtifactsFile returned status %d, want %d", errResp.Response.StatusCode, http.StatusNotFound)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Jobs.StreamArtifactsFile wrote %d bytes on error, want 0", b.Len())
+	}
+}
+
+func TestDownloadArtifactsFileWithSlashInRef(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/feature/x/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/feature%2Fx/download?job=build")
+		fmt.Fprint(w, "zipdata")
+	})
+
+	opt := &DownloadArtifactsFileOptions{Job: String("build")}
+	file, _, err := client.Jobs.DownloadArtifactsFile(1, "feature/x", opt)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadArtifactsFile returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(file)
+	if b.String() != "zipdata" {
+		t.Errorf("Jobs.DownloadArtifactsFile returned %q, want %q", b.String(), "zipdata")
+	}
+}
+
+func TestStreamArtifactsFileWithSpecialCharsInRef(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/fix/#1/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/fix%2F%231/download?job=build")
+		fmt.Fprint(w, "zipdata")
+	})
+
+	var b bytes.Buffer
+	opt := &DownloadArtifactsFileOptions{Job: String("build")}
+	_, err := client.Jobs.StreamArtifactsFile(1, "fix/#1", &b, opt)
+	if err != nil {
+		t.Fatalf("Jobs.StreamArtifactsFile returned error: %v", err)
+	}
+
+	if b.String() != "zipdata" {
+		t.Errorf("Jobs.StreamArtifactsFile wrote %q, want %q", b.String(), "zipdata")
+	}
+}
+
+func TestDownloadSingleArtifactsFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/reports/my coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/5/artifacts/reports/my%20coverage/index.html")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	file, _, err := client.Jobs.DownloadSingleArtifactsFile(1, 5, "reports/my coverage/index.html")
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFile returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(file)
+	if b.String() != "<html></html>" {
+		t.Errorf("Jobs.DownloadSingleArtifactsFile returned %q, want %q", b.String(), "<html></html>")
+	}
+}
+
+func TestDownloadSingleArtifactsFileByRefName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/raw/reports/coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/master/raw/reports/coverage/index.html?job=test")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	opt := &DownloadArtifactsFileOptions{Job: String("test")}
+	file, _, err := client.Jobs.DownloadSingleArtifactsFileByRefName(1, "master", "reports/coverage/index.html", opt)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFileByRefName returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(file)
+	if b.String() != "<html></html>" {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileByRefName returned %q, want %q", b.String(), "<html></html>")
+	}
+}
+
+func TestDownloadSingleArtifactsFileByRefNameWithSlashInRef(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/release/1.0/raw/reports/coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/release%2F1%2E0/raw/reports/coverage/index.html?job=test")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	opt := &DownloadArtifactsFileOptions{Job: String("test")}
+	file, _, err := client.Jobs.DownloadSingleArtifactsFileByRefName(1, "release/1.0", "reports/coverage/index.html", opt)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFileByRefName returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(file)
+	if b.String() != "<html></html>" {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileByRefName returned %q, want %q", b.String(), "<html></html>")
+	}
+}
+
+func TestStreamSingleArtifactsFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/reports/my coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/5/artifacts/reports/my%20coverage/index.html")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.StreamSingleArtifactsFile(1, 5, "reports/my coverage/index.html", &b)
+	if err != nil {
+		t.Fatalf("Jobs.StreamSingleArtifactsFile returned error: %v", err)
+	}
+
+	if b.String() != "<html></html>" {
+		t.Errorf("Jobs.StreamSingleArtifactsFile wrote %q, want %q", b.String(), "<html></html>")
+	}
+}
+
+func TestStreamSingleArtifactsFileByRefName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/feature/x/raw/reports/coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/feature%2Fx/raw/reports/coverage/index.html?job=test")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	var b bytes.Buffer
+	opt := &DownloadArtifactsFileOptions{Job: String("test")}
+	_, err := client.Jobs.StreamSingleArtifactsFileByRefName(1, "feature/x", "reports/coverage/index.html", &b, opt)
+	if err != nil {
+		t.Fatalf("Jobs.StreamSingleArtifactsFileByRefName returned error: %v", err)
+	}
+
+	if b.String() != "<html></html>" {
+		t.Errorf("Jobs.StreamSingleArtifactsFileByRefName wrote %q, want %q", b.String(), "<html></html>")
+	}
+}
+
+func TestKeepArtifacts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/keep", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":5,"name":"build"}`)
+	})
+
+	job, _, err := client.Jobs.KeepArtifacts(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.KeepArtifacts returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Name: "build"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.KeepArtifacts returned %+v, want %+v", job, want)
+	}
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Jobs.DeleteArtifacts(1, 5)
+	if err != nil {
+		t.Errorf("Jobs.DeleteArtifacts returned error: %v", err)
+	}
+}
+
+func TestDeleteProjectArtifacts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := client.Jobs.DeleteProjectArtifacts(1)
+	if err != nil {
+		t.Errorf("Jobs.DeleteProjectArtifacts returned error: %v", err)
+	}
+}
+
+func TestGetTraceFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "Running with gitlab-runner\nJob succeeded\n")
+	})
+
+	trace, _, err := client.Jobs.GetTraceFile(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFile returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(trace)
+	want := "Running with gitlab-runner\nJob succeeded\n"
+	if b.String() != want {
+		t.Errorf("Jobs.GetTraceFile returned %q, want %q", b.String(), want)
+	}
+}
+
+func TestGetTraceFileEmpty(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/plain")
+	})
+
+	trace, _, err := client.Jobs.GetTraceFile(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFile returned error: %v", err)
+	}
+
+	var b bytes.Buffer
+	b.ReadFrom(trace)
+	if b.Len() != 0 {
+		t.Errorf("Jobs.GetTraceFile returned %d bytes, want 0", b.Len())
+	}
+}
+
+func TestRetryJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/retry", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":6,"name":"test","status":"pending"}`)
+	})
+
+	job, _, err := client.Jobs.RetryJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.RetryJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 6, Name: "test", Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.RetryJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestCancelJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":5,"name":"test","status":"canceled"}`)
+	})
+
+	job, _, err := client.Jobs.CancelJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.CancelJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Name: "test", Status: "canceled"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.CancelJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestEraseJobForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/erase", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden  - Job is not erasable!"}`)
+	})
+
+	_, _, err := client.Jobs.EraseJob(1, 5)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Jobs.EraseJob returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("Jobs.EraseJob returned status %d, want %d", errResp.Response.StatusCode, http.StatusForbidden)
+	}
+}
+
+func TestPlayJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"job_variables_attributes":[{"key":"CHANGE_TICKET","value":"CHG-123"}]}`)
+		fmt.Fprint(w, `{"id":5,"name":"deploy","status":"pending"}`)
+	})
+
+	opt := &PlayJobOptions{
+		JobVariablesAttributes: []*JobVariableOptions{
+			{Key: String("CHANGE_TICKET"), Value: String("CHG-123")},
+		},
+	}
+
+	job, _, err := client.Jobs.PlayJob(1, 5, opt)
+	if err != nil {
+		t.Fatalf("Jobs.PlayJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Name: "deploy", Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.PlayJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestPlayJobNotPlayable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"400 Bad request - Unplayable Job"}`)
+	})
+
+	_, _, err := client.Jobs.PlayJob(1, 5, nil)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Jobs.PlayJob returned error %v, want *ErrorResponse", err)
+	}
+	if want := "{message: 400 Bad request - Unplayable Job}"; errResp.Message != want {
+		t.Errorf("Jobs.PlayJob returned message %q, want %q", errResp.Message, want)
+	}
+}
@@ -29,7 +29,8 @@ type LabelsService struct {
 	client *Client
 }
 
-// Label represents a GitLab label.
+// Label represents a GitLab label. The issue and merge request counts are
+// only set when listing labels with WithCounts enabled.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/labels.html
 type Label struct {
@@ -75,8 +76,9 @@ func (l Label) String() string {
 // GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#list-labels
 type ListLabelsOptions struct {
 	ListOptions
-	WithCounts            *bool `url:"with_counts,omitempty" json:"with_counts,omitempty"`
-	IncludeAncestorGroups *bool `url:"include_ancestor_groups,omitempty" json:"include_ancestor_groups,omitempty"`
+	WithCounts            *bool   `url:"with_counts,omitempty" json:"with_counts,omitempty"`
+	IncludeAncestorGroups *bool   `url:"include_ancestor_groups,omitempty" json:"include_ancestor_groups,omitempty"`
+	Search                *string `url:"search,omitempty" json:"search,omitempty"`
 }
 
 // ListLabels gets all labels for given project.
@@ -115,7 +117,7 @@ func (s *LabelsService) GetLabel(pid interface{}, labelID interface{}, options .
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s", pathEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -138,6 +140,7 @@ type CreateLabelOptions struct {
 	Name        *string `url:"name,omitempty" json:"name,omitempty"`
 	Color       *string `url:"color,omitempty" json:"color,omitempty"`
 	Description *string `url:"description,omitempty" json:"description,omitempty"`
+	Priority    *int    `url:"priority,omitempty" json:"priority,omitempty"`
 }
 
 // CreateLabel creates a new label for given repository with given name and
@@ -190,18 +193,47 @@ func (s *LabelsService) DeleteLabel(pid interface{}, opt *DeleteLabelOptions, op
 	return s.client.Do(req, nil)
 }
 
+// LabelPriority is a custom type used to set or remove the priority of a
+// label. Use SetLabelPriority to set a priority and RemoveLabelPriority to
+// remove it.
+type LabelPriority struct {
+	priority *int
+}
+
+// SetLabelPriority returns a LabelPriority that sets the priority of a
+// label to v.
+func SetLabelPriority(v int) *LabelPriority {
+	return &LabelPriority{priority: Int(v)}
+}
+
+// RemoveLabelPriority returns a LabelPriority that removes the priority of
+// a label.
+func RemoveLabelPriority() *LabelPriority {
+	return &LabelPriority{}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p *LabelPriority) MarshalJSON() ([]byte, error) {
+	if p.priority == nil {
+		return []byte(`null`), nil
+	}
+	return json.Marshal(*p.priority)
+}
+
 // UpdateLabelOptions represents the available UpdateLabel() options.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#delete-a-label
+// GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#edit-an-existing-label
 type UpdateLabelOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	NewName     *string `url:"new_name,omitempty" json:"new_name,omitempty"`
-	Color       *string `url:"color,omitempty" json:"color,omitempty"`
-	Description *string `url:"description,omitempty" json:"description,omitempty"`
+	Name        *string        `url:"name,omitempty" json:"name,omitempty"`
+	NewName     *string        `url:"new_name,omitempty" json:"new_name,omitempty"`
+	Color       *string        `url:"color,omitempty" json:"color,omitempty"`
+	Description *string        `url:"description,omitempty" json:"description,omitempty"`
+	Priority    *LabelPriority `url:"-" json:"priority,omitempty"`
 }
 
 // UpdateLabel updates an existing label with new name or now color. At least
-// one parameter is required, to update the label.
+// one parameter is required, to update the label. The label to update is
+// identified by its current name, which is sent in the request body.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/labels.html#edit-an-existing-label
 func (s *LabelsService) UpdateLabel(pid interface{}, opt *UpdateLabelOptions, options ...RequestOptionFunc) (*Label, *Response, error) {
@@ -240,7 +272,7 @@ func (s *LabelsService) SubscribeToLabel(pid interface{}, labelID interface{}, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", pathEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -271,7 +303,7 @@ func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, labelID interface{
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s/unsubscribe", pathEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s/unsubscribe", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -294,7 +326,7 @@ func (s *LabelsService) PromoteLabel(pid interface{}, labelID interface{}, optio
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s/promote", pathEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s/promote", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("PUT", u, nil, options)
 	if err != nil {
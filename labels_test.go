@@ -85,6 +85,108 @@ func TestUpdateLabel(t *testing.T) {
 	}
 }
 
+func TestUpdateLabelPriority(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"bug","priority":3}`)
+		fmt.Fprint(w, `{"id":1, "name": "bug", "priority": 3}`)
+	})
+
+	opt := &UpdateLabelOptions{
+		Name:     String("bug"),
+		Priority: SetLabelPriority(3),
+	}
+	label, _, err := client.Labels.UpdateLabel("1", opt)
+	if err != nil {
+		t.Fatalf("Labels.UpdateLabel returned error: %v", err)
+	}
+
+	want := &Label{ID: 1, Name: "bug", Priority: 3}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("Labels.UpdateLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestUpdateLabelRemovePriority(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"bug","priority":null}`)
+		fmt.Fprint(w, `{"id":1, "name": "bug", "priority": null}`)
+	})
+
+	opt := &UpdateLabelOptions{
+		Name:     String("bug"),
+		Priority: RemoveLabelPriority(),
+	}
+	label, _, err := client.Labels.UpdateLabel("1", opt)
+	if err != nil {
+		t.Fatalf("Labels.UpdateLabel returned error: %v", err)
+	}
+
+	want := &Label{ID: 1, Name: "bug"}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("Labels.UpdateLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestCreateLabelWithPriority(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"bug","color":"#d9534f","priority":1}`)
+		fmt.Fprint(w, `{"id":1, "name": "bug", "color": "#d9534f", "priority": 1}`)
+	})
+
+	opt := &CreateLabelOptions{
+		Name:     String("bug"),
+		Color:    String("#d9534f"),
+		Priority: Int(1),
+	}
+	label, _, err := client.Labels.CreateLabel("1", opt)
+	if err != nil {
+		t.Fatalf("Labels.CreateLabel returned error: %v", err)
+	}
+
+	want := &Label{ID: 1, Name: "bug", Color: "#d9534f", Priority: 1}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("Labels.CreateLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestListLabelsWithSearch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/labels?include_ancestor_groups=true&search=bug&with_counts=true")
+		fmt.Fprint(w, `[{"id":1, "name": "bug", "open_issues_count": 2}]`)
+	})
+
+	opt := &ListLabelsOptions{
+		WithCounts:            Bool(true),
+		IncludeAncestorGroups: Bool(true),
+		Search:                String("bug"),
+	}
+	labels, _, err := client.Labels.ListLabels("1", opt)
+	if err != nil {
+		t.Fatalf("Labels.ListLabels returned error: %v", err)
+	}
+
+	want := []*Label{{ID: 1, Name: "bug", OpenIssuesCount: 2}}
+	if !reflect.DeepEqual(want, labels) {
+		t.Errorf("Labels.ListLabels returned %+v, want %+v", labels, want)
+	}
+}
+
 func TestSubscribeToLabel(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -163,3 +265,22 @@ func TestGetLabel(t *testing.T) {
 		t.Errorf("Labels.GetLabel returned %+v, want %+v", label, want)
 	}
 }
+
+func TestPromoteLabel(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels/5/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"id":5,"name":"bug","color":"#d9534f"}`)
+	})
+
+	resp, err := client.Labels.PromoteLabel("1", 5)
+	if err != nil {
+		t.Fatalf("Labels.PromoteLabel returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Labels.PromoteLabel returned status %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+}
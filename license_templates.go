@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // LicenseTemplate represents a license template.
@@ -75,7 +76,7 @@ type GetLicenseTemplateOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/templates/licenses.html#single-license-template
 func (s *LicenseTemplatesService) GetLicenseTemplate(template string, opt *GetLicenseTemplateOptions, options ...RequestOptionFunc) (*LicenseTemplate, *Response, error) {
-	u := fmt.Sprintf("templates/licenses/%s", template)
+	u := fmt.Sprintf("templates/licenses/%s", url.PathEscape(template))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -0,0 +1,62 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListLicenseTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/templates/licenses?popular=true")
+		fmt.Fprint(w, `[{"key":"apache-2.0","name":"Apache License 2.0","nickname":null,"featured":true,"html_url":"http://choosealicense.com/licenses/apache-2.0/","permissions":["commercial-use","modifications"],"conditions":["include-copyright"],"limitations":["trademark-use"]}]`)
+	})
+
+	templates, _, err := client.LicenseTemplates.ListLicenseTemplates(&ListLicenseTemplatesOptions{Popular: Bool(true)})
+	if err != nil {
+		t.Fatalf("LicenseTemplates.ListLicenseTemplates returned error: %v", err)
+	}
+
+	want := []*LicenseTemplate{{
+		Key:         "apache-2.0",
+		Name:        "Apache License 2.0",
+		Featured:    true,
+		HTMLURL:     "http://choosealicense.com/licenses/apache-2.0/",
+		Permissions: []string{"commercial-use", "modifications"},
+		Conditions:  []string{"include-copyright"},
+		Limitations: []string{"trademark-use"},
+	}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("LicenseTemplates.ListLicenseTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetLicenseTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/templates/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/templates/licenses/mit?fullname=Jane+Doe&project=my-project")
+		fmt.Fprint(w, `{"key":"mit","name":"MIT License","nickname":null,"content":"MIT License\n\nCopyright (c) 2021 Jane Doe\n"}`)
+	})
+
+	opt := &GetLicenseTemplateOptions{
+		Project:  String("my-project"),
+		Fullname: String("Jane Doe"),
+	}
+	template, _, err := client.LicenseTemplates.GetLicenseTemplate("mit", opt)
+	if err != nil {
+		t.Fatalf("LicenseTemplates.GetLicenseTemplate returned error: %v", err)
+	}
+
+	want := &LicenseTemplate{Key: "mit", Name: "MIT License", Content: "MIT License\n\nCopyright (c) 2021 Jane Doe\n"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("LicenseTemplates.GetLicenseTemplate returned %+v, want %+v", template, want)
+	}
+}
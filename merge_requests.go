@@ -30,7 +30,8 @@ type MergeRequestsService struct {
 	timeStats *timeStatsService
 }
 
-// MergeRequest represents a GitLab merge request.
+// MergeRequest represents a GitLab merge request. The Subscribed field is
+// only set when getting a single merge request.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/merge_requests.html
 type MergeRequest struct {
@@ -153,6 +154,7 @@ type ListMergeRequestsOptions struct {
 	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
 	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
 	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed             *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 	In                     *string    `url:"in,omitempty" json:"in,omitempty"`
 	WIP                    *string    `url:"wip,omitempty" json:"wip,omitempty"`
 }
@@ -206,6 +208,7 @@ type ListGroupMergeRequestsOptions struct {
 	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
 	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
 	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed             *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 }
 
 // ListGroupMergeRequests gets all merge requests for this group.
@@ -261,6 +264,7 @@ type ListProjectMergeRequestsOptions struct {
 	SourceBranch           *string    `url:"source_branch,omitempty" json:"source_branch,omitempty"`
 	TargetBranch           *string    `url:"target_branch,omitempty" json:"target_branch,omitempty"`
 	Search                 *string    `url:"search,omitempty" json:"search,omitempty"`
+	Subscribed             *bool      `url:"subscribed,omitempty" json:"subscribed,omitempty"`
 	WIP                    *string    `url:"wip,omitempty" json:"wip,omitempty"`
 }
 
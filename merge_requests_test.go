@@ -211,3 +211,19 @@ func TestGetMergeRequestParticipants(t *testing.T) {
 		t.Errorf("Issues.GetMergeRequestParticipants returned %+v, want %+v", mergeRequestParticipants, want)
 	}
 }
+
+func TestListGroupMergeRequestsSubscribed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/merge_requests?subscribed=false")
+		fmt.Fprint(w, `[{"id":2,"iid":3}]`)
+	})
+
+	opts := &ListGroupMergeRequestsOptions{Subscribed: Bool(false)}
+	mrs, _, err := client.MergeRequests.ListGroupMergeRequests(1, opts)
+	require.NoError(t, err)
+	require.Equal(t, []*MergeRequest{{ID: 2, IID: 3}}, mrs)
+}
@@ -57,7 +57,7 @@ func (m Milestone) String() string {
 // https://docs.gitlab.com/ce/api/milestones.html#list-project-milestones
 type ListMilestonesOptions struct {
 	ListOptions
-	IIDs   []int   `url:"iids,omitempty" json:"iids,omitempty"`
+	IIDs   []int   `url:"iids[],omitempty" json:"iids,omitempty"`
 	Title  *string `url:"title,omitempty" json:"title,omitempty"`
 	State  *string `url:"state,omitempty" json:"state,omitempty"`
 	Search *string `url:"search,omitempty" json:"search,omitempty"`
@@ -267,3 +267,63 @@ func (s *MilestonesService) GetMilestoneMergeRequests(pid interface{}, milestone
 
 	return mr, resp, err
 }
+
+// GetMilestoneBurndownChartEventsOptions represents the available
+// GetMilestoneBurndownChartEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+type GetMilestoneBurndownChartEventsOptions ListOptions
+
+// GetMilestoneBurndownChartEvents gets all burndown chart events for a
+// single project milestone.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+func (s *MilestonesService) GetMilestoneBurndownChartEvents(pid interface{}, milestone int, opt *GetMilestoneBurndownChartEventsOptions, options ...RequestOptionFunc) ([]*BurndownChartEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/burndown_events", pathEscape(project), milestone)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var be []*BurndownChartEvent
+	resp, err := s.client.Do(req, &be)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return be, resp, err
+}
+
+// PromoteMilestone promotes a project milestone to a group milestone. Note
+// that the promoted milestone is a new group milestone with a different ID,
+// and the original project milestone ID can no longer be used.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#promote-project-milestone-to-a-group-milestone
+func (s *MilestonesService) PromoteMilestone(pid interface{}, milestone int, options ...RequestOptionFunc) (*Milestone, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/promote", pathEscape(project), milestone)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(Milestone)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListMilestones(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/milestones?iids%5B%5D=1&iids%5B%5D=2&search=sprint&state=active")
+		fmt.Fprint(w, `[{"id":12,"iid":1,"project_id":5,"title":"Sprint 1","state":"active"}]`)
+	})
+
+	opt := &ListMilestonesOptions{
+		IIDs:   []int{1, 2},
+		State:  String("active"),
+		Search: String("sprint"),
+	}
+	milestones, _, err := client.Milestones.ListMilestones(5, opt)
+	if err != nil {
+		t.Fatalf("Milestones.ListMilestones returned error: %v", err)
+	}
+
+	want := []*Milestone{{ID: 12, IID: 1, ProjectID: 5, Title: "Sprint 1", State: "active"}}
+	if !reflect.DeepEqual(want, milestones) {
+		t.Errorf("Milestones.ListMilestones returned %+v, want %+v", milestones, want)
+	}
+}
+
+func TestGetMilestoneIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":41,"iid":3,"project_id":5,"title":"Fix login"}]`)
+	})
+
+	issues, _, err := client.Milestones.GetMilestoneIssues(5, 12, nil)
+	if err != nil {
+		t.Fatalf("Milestones.GetMilestoneIssues returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 41, IID: 3, ProjectID: 5, Title: "Fix login"}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Milestones.GetMilestoneIssues returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestGetMilestoneMergeRequests(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":7,"iid":2,"project_id":5,"title":"Add login form"}]`)
+	})
+
+	mrs, _, err := client.Milestones.GetMilestoneMergeRequests(5, 12, nil)
+	if err != nil {
+		t.Fatalf("Milestones.GetMilestoneMergeRequests returned error: %v", err)
+	}
+
+	want := []*MergeRequest{{ID: 7, IID: 2, ProjectID: 5, Title: "Add login form"}}
+	if !reflect.DeepEqual(want, mrs) {
+		t.Errorf("Milestones.GetMilestoneMergeRequests returned %+v, want %+v", mrs, want)
+	}
+}
+
+func TestGetMilestoneBurndownChartEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/burndown_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"created_at":"2021-03-01T10:00:00Z","weight":2,"action":"created"},{"created_at":"2021-03-02T12:30:00Z","weight":null,"action":"closed"}]`)
+	})
+
+	events, _, err := client.Milestones.GetMilestoneBurndownChartEvents(5, 12, nil)
+	if err != nil {
+		t.Fatalf("Milestones.GetMilestoneBurndownChartEvents returned error: %v", err)
+	}
+
+	created := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	closed := time.Date(2021, 3, 2, 12, 30, 0, 0, time.UTC)
+	want := []*BurndownChartEvent{
+		{CreatedAt: &created, Weight: Int(2), Action: String("created")},
+		{CreatedAt: &closed, Action: String("closed")},
+	}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Milestones.GetMilestoneBurndownChartEvents returned %+v, want %+v", events, want)
+	}
+}
+
+func TestPromoteMilestone(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":42,"iid":1,"title":"Sprint 1","state":"active"}`)
+	})
+
+	milestone, _, err := client.Milestones.PromoteMilestone(5, 12)
+	if err != nil {
+		t.Fatalf("Milestones.PromoteMilestone returned error: %v", err)
+	}
+
+	want := &Milestone{ID: 42, IID: 1, Title: "Sprint 1", State: "active"}
+	if !reflect.DeepEqual(want, milestone) {
+		t.Errorf("Milestones.PromoteMilestone returned %+v, want %+v", milestone, want)
+	}
+}
@@ -56,6 +56,8 @@ type Note struct {
 	Position     *NotePosition `json:"position"`
 	Resolvable   bool          `json:"resolvable"`
 	Resolved     bool          `json:"resolved"`
+	Confidential bool          `json:"confidential"`
+	Internal     bool          `json:"internal"`
 	ResolvedBy   struct {
 		ID        int    `json:"id"`
 		Username  string `json:"username"`
@@ -70,18 +72,33 @@ type Note struct {
 
 // NotePosition represents the position attributes of a note.
 type NotePosition struct {
-	BaseSHA      string `json:"base_sha"`
-	StartSHA     string `json:"start_sha"`
-	HeadSHA      string `json:"head_sha"`
-	PositionType string `json:"position_type"`
-	NewPath      string `json:"new_path,omitempty"`
-	NewLine      int    `json:"new_line,omitempty"`
-	OldPath      string `json:"old_path,omitempty"`
-	OldLine      int    `json:"old_line,omitempty"`
-	Width        int    `json:"width,omitempty"`
-	Height       int    `json:"height,omitempty"`
-	X            int    `json:"x,omitempty"`
-	Y            int    `json:"y,omitempty"`
+	BaseSHA      string     `json:"base_sha"`
+	StartSHA     string     `json:"start_sha"`
+	HeadSHA      string     `json:"head_sha"`
+	PositionType string     `json:"position_type"`
+	NewPath      string     `json:"new_path,omitempty"`
+	NewLine      int        `json:"new_line,omitempty"`
+	OldPath      string     `json:"old_path,omitempty"`
+	OldLine      int        `json:"old_line,omitempty"`
+	LineRange    *LineRange `json:"line_range,omitempty"`
+	Width        int        `json:"width,omitempty"`
+	Height       int        `json:"height,omitempty"`
+	X            int        `json:"x,omitempty"`
+	Y            int        `json:"y,omitempty"`
+}
+
+// LineRange represents the range of lines a multi-line note is placed on.
+type LineRange struct {
+	Start *LinePosition `json:"start,omitempty"`
+	End   *LinePosition `json:"end,omitempty"`
+}
+
+// LinePosition represents a single line of a multi-line note's range.
+type LinePosition struct {
+	LineCode string `json:"line_code,omitempty"`
+	Type     string `json:"type,omitempty"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
 }
 
 func (n Note) String() string {
@@ -98,7 +115,9 @@ type ListIssueNotesOptions struct {
 	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
-// ListIssueNotes gets a list of all notes for a single issue.
+// ListIssueNotes gets a list of all notes for a single issue. Notes can be
+// ordered by created_at or updated_at. System notes, such as label or
+// assignee changes, are included and can be told apart by their System field.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/notes.html#list-project-issue-notes
@@ -156,9 +175,13 @@ func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ..
 type CreateIssueNoteOptions struct {
 	Body      *string    `url:"body,omitempty" json:"body,omitempty"`
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
+	// Confidential parameter was deprecated in GitLab 15.3 in favor of Internal
+	Confidential *bool `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Internal     *bool `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
-// CreateIssueNote creates a new note to a single project issue.
+// CreateIssueNote creates a new note to a single project issue. Internal
+// notes are only visible to project members with at least the Reporter role.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/notes.html#create-new-issue-note
@@ -599,10 +622,12 @@ func (s *NotesService) GetEpicNote(gid interface{}, epic, note int, options ...R
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
 type CreateEpicNoteOptions struct {
-	Body *string `url:"body,omitempty" json:"body,omitempty"`
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
-// CreateEpicNote creates a new note for a single merge request.
+// CreateEpicNote creates a new note for a single epic. Epics are addressed
+// by their group and epic ID, not by a project.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
@@ -637,6 +662,7 @@ type UpdateEpicNoteOptions struct {
 
 // UpdateEpicNote modifies existing note of an epic.
 //
+// GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#modify-existing-epic-note
 func (s *NotesService) UpdateEpicNote(gid interface{}, epic, note int, opt *UpdateEpicNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
 	group, err := parseID(gid)
@@ -659,8 +685,9 @@ func (s *NotesService) UpdateEpicNote(gid interface{}, epic, note int, opt *Upda
 	return n, resp, err
 }
 
-// DeleteEpicNote deletes an existing note of a merge request.
+// DeleteEpicNote deletes an existing note of an epic.
 //
+// GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#delete-an-epic-note
 func (s *NotesService) DeleteEpicNote(gid interface{}, epic, note int, options ...RequestOptionFunc) (*Response, error) {
 	group, err := parseID(gid)
@@ -36,3 +36,229 @@ func TestGetEpicNote(t *testing.T) {
 		t.Errorf("Notes.GetEpicNote want %#v, got %#v", note, want)
 	}
 }
+
+func TestCreateConfidentialIssueNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"foo bar","confidential":true}`)
+		fmt.Fprint(w, `{"id":3,"body":"foo bar","system":false,"noteable_id":4392,"noteable_type":"Issue","confidential":true}`)
+	})
+
+	opt := &CreateIssueNoteOptions{
+		Body:         String("foo bar"),
+		Confidential: Bool(true),
+	}
+
+	note, _, err := client.Notes.CreateIssueNote("1", 4, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{
+		ID:           3,
+		Body:         "foo bar",
+		NoteableID:   4392,
+		NoteableType: "Issue",
+		Confidential: true,
+	}
+
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateIssueNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestListIssueNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/issues/4/notes?order_by=created_at&sort=asc")
+		fmt.Fprint(w, `[{"id":1,"body":"added ~1 label","system":true,"noteable_iid":4},{"id":2,"body":"Looks good","system":false,"noteable_iid":4,"resolvable":true,"resolved":true,"resolved_by":{"id":5,"username":"jdoe"}}]`)
+	})
+
+	opt := &ListIssueNotesOptions{
+		OrderBy: String("created_at"),
+		Sort:    String("asc"),
+	}
+	notes, _, err := client.Notes.ListIssueNotes("1", 4, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	system := &Note{ID: 1, Body: "added ~1 label", System: true, NoteableIID: 4}
+	human := &Note{ID: 2, Body: "Looks good", NoteableIID: 4, Resolvable: true, Resolved: true}
+	human.ResolvedBy.ID = 5
+	human.ResolvedBy.Username = "jdoe"
+	want := []*Note{system, human}
+
+	if !reflect.DeepEqual(notes, want) {
+		t.Errorf("Notes.ListIssueNotes want %#v, got %#v", want, notes)
+	}
+}
+
+func TestCreateInternalIssueNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"summary","internal":true}`)
+		fmt.Fprint(w, `{"id":3,"body":"summary","noteable_type":"Issue","internal":true}`)
+	})
+
+	opt := &CreateIssueNoteOptions{
+		Body:     String("summary"),
+		Internal: Bool(true),
+	}
+	note, _, err := client.Notes.CreateIssueNote("1", 4, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{ID: 3, Body: "summary", NoteableType: "Issue", Internal: true}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateIssueNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestUpdateMergeRequestNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4/notes/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"body":"updated"}`)
+		fmt.Fprint(w, `{"id":3,"body":"updated","noteable_type":"MergeRequest"}`)
+	})
+
+	note, _, err := client.Notes.UpdateMergeRequestNote("1", 4, 3, &UpdateMergeRequestNoteOptions{Body: String("updated")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{ID: 3, Body: "updated", NoteableType: "MergeRequest"}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.UpdateMergeRequestNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestDeleteSnippetNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/4/notes/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Notes.DeleteSnippetNote("1", 4, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListEpicNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/epics/12/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/my-group/epics/12/notes?sort=desc")
+		fmt.Fprint(w, `[{"id":7,"body":"Weekly status: on track","noteable_id":12,"noteable_type":"Epic"}]`)
+	})
+
+	notes, _, err := client.Notes.ListEpicNotes("my-group", 12, &ListEpicNotesOptions{Sort: String("desc")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Note{{ID: 7, Body: "Weekly status: on track", NoteableID: 12, NoteableType: "Epic"}}
+	if !reflect.DeepEqual(notes, want) {
+		t.Errorf("Notes.ListEpicNotes want %#v, got %#v", want, notes)
+	}
+}
+
+func TestCreateEpicNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/epics/12/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Weekly status: on track","internal":true}`)
+		fmt.Fprint(w, `{"id":7,"body":"Weekly status: on track","noteable_id":12,"noteable_type":"Epic","internal":true}`)
+	})
+
+	opt := &CreateEpicNoteOptions{
+		Body:     String("Weekly status: on track"),
+		Internal: Bool(true),
+	}
+	note, _, err := client.Notes.CreateEpicNote("my-group", 12, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{ID: 7, Body: "Weekly status: on track", NoteableID: 12, NoteableType: "Epic", Internal: true}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateEpicNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestDeleteEpicNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/epics/12/notes/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Notes.DeleteEpicNote("my-group", 12, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateSnippetNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Looks fine"}`)
+		fmt.Fprint(w, `{"id":3,"body":"Looks fine","noteable_id":4,"noteable_type":"Snippet"}`)
+	})
+
+	note, _, err := client.Notes.CreateSnippetNote("1", 4, &CreateSnippetNoteOptions{Body: String("Looks fine")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{ID: 3, Body: "Looks fine", NoteableID: 4, NoteableType: "Snippet"}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateSnippetNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestListSnippetNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/snippets/4/notes?order_by=updated_at")
+		fmt.Fprint(w, `[{"id":3,"body":"Looks fine","noteable_id":4,"noteable_type":"Snippet"}]`)
+	})
+
+	opt := &ListSnippetNotesOptions{OrderBy: String("updated_at")}
+	notes, _, err := client.Notes.ListSnippetNotes("1", 4, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Note{{ID: 3, Body: "Looks fine", NoteableID: 4, NoteableType: "Snippet"}}
+	if !reflect.DeepEqual(notes, want) {
+		t.Errorf("Notes.ListSnippetNotes want %#v, got %#v", want, notes)
+	}
+}
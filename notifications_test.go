@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetGlobalSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"level":"participating","notification_email":"admin@example.com"}`)
+	})
+
+	settings, _, err := client.NotificationSettings.GetGlobalSettings()
+	if err != nil {
+		t.Fatalf("NotificationSettings.GetGlobalSettings returned error: %v", err)
+	}
+
+	want := &NotificationSettings{
+		Level:             ParticipatingNotificationLevel,
+		NotificationEmail: "admin@example.com",
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("NotificationSettings.GetGlobalSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateGlobalSettingsRejectsGlobalLevel(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	opt := &NotificationSettingsOptions{Level: NotificationLevel(GlobalNotificationLevel)}
+	_, _, err := client.NotificationSettings.UpdateGlobalSettings(opt)
+	if err == nil {
+		t.Fatal("NotificationSettings.UpdateGlobalSettings expected an error for the global level")
+	}
+}
+
+func TestUpdateSettingsForGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-team/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"level":"watch"}`)
+		fmt.Fprint(w, `{"level":"watch"}`)
+	})
+
+	opt := &NotificationSettingsOptions{Level: NotificationLevel(WatchNotificationLevel)}
+	settings, _, err := client.NotificationSettings.UpdateSettingsForGroup("my-team", opt)
+	if err != nil {
+		t.Fatalf("NotificationSettings.UpdateSettingsForGroup returned error: %v", err)
+	}
+
+	want := &NotificationSettings{Level: WatchNotificationLevel}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("NotificationSettings.UpdateSettingsForGroup returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateSettingsForProjectCustomLevel(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"level":"custom","failed_pipeline":true,"new_note":false,"new_release":true}`)
+		fmt.Fprint(w, `{"level":"custom","events":{"failed_pipeline":true,"new_note":false,"new_release":true,"merge_merge_request":false}}`)
+	})
+
+	opt := &NotificationSettingsOptions{
+		Level:          NotificationLevel(CustomNotificationLevel),
+		FailedPipeline: Bool(true),
+		NewNote:        Bool(false),
+		NewRelease:     Bool(true),
+	}
+	settings, _, err := client.NotificationSettings.UpdateSettingsForProject(1, opt)
+	if err != nil {
+		t.Fatalf("NotificationSettings.UpdateSettingsForProject returned error: %v", err)
+	}
+
+	want := &NotificationSettings{
+		Level: CustomNotificationLevel,
+		Events: &NotificationEvents{
+			FailedPipeline: true,
+			NewRelease:     true,
+		},
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("NotificationSettings.UpdateSettingsForProject returned %+v, want %+v", settings, want)
+	}
+}
@@ -0,0 +1,148 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectPackages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/3/packages?order_by=version&package_name=my-app&package_type=maven&sort=desc")
+		fmt.Fprint(w, `[{"id":3,"name":"Hello/0.1@mycompany/stable","version":"0.1-SNAPSHOT","package_type":"maven","status":"default","_links":{"web_path":"/foo/bar/-/packages/3","delete_api_path":"https://gitlab.example.com/api/v4/projects/3/packages/3"},"tags":[]}]`)
+	})
+
+	opt := &ListProjectPackagesOptions{
+		OrderBy:     String("version"),
+		Sort:        String("desc"),
+		PackageType: PackageType(MavenPackageType),
+		PackageName: String("my-app"),
+	}
+
+	packages, _, err := client.Packages.ListProjectPackages(3, opt)
+	if err != nil {
+		t.Fatalf("Packages.ListProjectPackages returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:          3,
+		Name:        "Hello/0.1@mycompany/stable",
+		Version:     "0.1-SNAPSHOT",
+		PackageType: MavenPackageType,
+		Status:      "default",
+		Links: &PackageLinks{
+			WebPath:       "/foo/bar/-/packages/3",
+			DeleteAPIPath: "https://gitlab.example.com/api/v4/projects/3/packages/3",
+		},
+		Tags: []*PackageTag{},
+	}}
+	if !reflect.DeepEqual(want, packages) {
+		t.Errorf("Packages.ListProjectPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestListGroupPackages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/5/packages?exclude_subgroups=true&package_type=npm")
+		fmt.Fprint(w, `[{"id":1,"name":"@foo/bar","version":"1.0.3","package_type":"npm","project_id":9,"project_path":"group/project"}]`)
+	})
+
+	opt := &ListGroupPackagesOptions{
+		ExcludeSubGroups: Bool(true),
+		PackageType:      PackageType(NPMPackageType),
+	}
+
+	packages, _, err := client.Packages.ListGroupPackages(5, opt)
+	if err != nil {
+		t.Fatalf("Packages.ListGroupPackages returned error: %v", err)
+	}
+
+	want := []*GroupPackage{{
+		Package: Package{
+			ID:          1,
+			Name:        "@foo/bar",
+			Version:     "1.0.3",
+			PackageType: NPMPackageType,
+		},
+		ProjectID:   9,
+		ProjectPath: "group/project",
+	}}
+	if !reflect.DeepEqual(want, packages) {
+		t.Errorf("Packages.ListGroupPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestListPackageFiles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4/package_files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":25,"package_id":4,"file_name":"my-app-1.5-20181107.152550-1.jar","size":2421,"file_md5":"58e6a45a629910c6ff99145a688971ac","file_sha1":"ebd193463d3915d7e22219f52740056dfd26cbfe","file_sha256":"a903393463d3915d7e22219f52740056dfd26cbfeff321b"}]`)
+	})
+
+	files, _, err := client.Packages.ListPackageFiles(3, 4, nil)
+	if err != nil {
+		t.Fatalf("Packages.ListPackageFiles returned error: %v", err)
+	}
+
+	want := []*PackageFile{{
+		ID:         25,
+		PackageID:  4,
+		FileName:   "my-app-1.5-20181107.152550-1.jar",
+		Size:       2421,
+		FileMD5:    "58e6a45a629910c6ff99145a688971ac",
+		FileSHA1:   "ebd193463d3915d7e22219f52740056dfd26cbfe",
+		FileSHA256: "a903393463d3915d7e22219f52740056dfd26cbfeff321b",
+	}}
+	if !reflect.DeepEqual(want, files) {
+		t.Errorf("Packages.ListPackageFiles returned %+v, want %+v", files, want)
+	}
+}
+
+func TestDeleteProjectPackage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Packages.DeleteProjectPackage(3, 4)
+	if err != nil {
+		t.Fatalf("Packages.DeleteProjectPackage returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Packages.DeleteProjectPackage returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDeletePackageFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4/package_files/25", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Packages.DeletePackageFile(3, 4, 25)
+	if err != nil {
+		t.Fatalf("Packages.DeletePackageFile returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Packages.DeletePackageFile returned status %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
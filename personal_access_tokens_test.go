@@ -0,0 +1,140 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/personal_access_tokens?last_used_before=2021-01-01T00%3A00%3A00Z&revoked=false&state=active&user_id=3")
+		fmt.Fprint(w, `[{"id": 4, "name": "Test Token", "revoked": false, "created_at": "2020-07-23T14:31:47Z", "scopes": ["api"], "user_id": 3, "last_used_at": "2020-10-06T17:58:37Z", "active": true, "expires_at": null}]`)
+	})
+
+	lastUsedBefore := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListPersonalAccessTokensOptions{
+		UserID:         Int(3),
+		Revoked:        Bool(false),
+		State:          String("active"),
+		LastUsedBefore: &lastUsedBefore,
+	}
+	pats, _, err := client.PersonalAccessTokens.ListPersonalAccessTokens(opt)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.ListPersonalAccessTokens returned error: %v", err)
+	}
+
+	createdAt := time.Date(2020, time.July, 23, 14, 31, 47, 0, time.UTC)
+	lastUsedAt := time.Date(2020, time.October, 6, 17, 58, 37, 0, time.UTC)
+	want := []*PersonalAccessToken{{
+		ID:         4,
+		Name:       "Test Token",
+		CreatedAt:  &createdAt,
+		Scopes:     []string{"api"},
+		UserID:     3,
+		LastUsedAt: &lastUsedAt,
+		Active:     true,
+	}}
+	if !reflect.DeepEqual(want, pats) {
+		t.Errorf("PersonalAccessTokens.ListPersonalAccessTokens returned %+v, want %+v", pats, want)
+	}
+}
+
+func TestGetSinglePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 4, "name": "Test Token", "user_id": 3, "active": true}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken(4)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.GetSinglePersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 4, Name: "Test Token", UserID: 3, Active: true}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.GetSinglePersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/3/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ci","expires_at":"2021-06-30","scopes":["read_api"]}`)
+		fmt.Fprint(w, `{"id": 5, "name": "ci", "user_id": 3, "scopes": ["read_api"], "expires_at": "2021-06-30", "token": "glpat-secret"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC))
+	opt := &CreatePersonalAccessTokenOptions{
+		Name:      String("ci"),
+		ExpiresAt: &expiresAt,
+		Scopes:    &[]string{"read_api"},
+	}
+	pat, _, err := client.PersonalAccessTokens.CreatePersonalAccessToken(3, opt)
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.CreatePersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 5, Name: "ci", UserID: 3, Scopes: []string{"read_api"}, ExpiresAt: &expiresAt, Token: "glpat-secret"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.CreatePersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.PersonalAccessTokens.RevokePersonalAccessToken(4)
+	if err != nil {
+		t.Errorf("PersonalAccessTokens.RevokePersonalAccessToken returned error: %v", err)
+	}
+
+	_, err = client.PersonalAccessTokens.RevokePersonalAccessTokenSelf()
+	if err != nil {
+		t.Errorf("PersonalAccessTokens.RevokePersonalAccessTokenSelf returned error: %v", err)
+	}
+}
+
+func TestRotatePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/4/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"expires_at":"2021-12-31"}`)
+		fmt.Fprint(w, `{"id": 42, "name": "Test Token", "revoked": false, "active": true, "user_id": 3, "expires_at": "2021-12-31", "token": "glpat-rotated"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.PersonalAccessTokens.RotatePersonalAccessToken(4, &RotatePersonalAccessTokenOptions{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.RotatePersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 42, Name: "Test Token", Active: true, UserID: 3, ExpiresAt: &expiresAt, Token: "glpat-rotated"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("PersonalAccessTokens.RotatePersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
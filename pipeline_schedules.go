@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -53,13 +54,13 @@ type PipelineSchedule struct {
 	Variables []*PipelineVariable `json:"variables"`
 }
 
-// ListPipelineSchedulesOptions represents the available ListPipelineTriggers() options.
+// ListPipelineSchedulesOptions represents the available ListPipelineSchedules() options.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/pipeline_triggers.html#list-project-triggers
+// https://docs.gitlab.com/ce/api/pipeline_schedules.html#get-all-pipeline-schedules
 type ListPipelineSchedulesOptions ListOptions
 
-// ListPipelineSchedules gets a list of project triggers.
+// ListPipelineSchedules gets a list of project pipeline schedules.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html
@@ -255,9 +256,9 @@ func (s *PipelineSchedulesService) RunPipelineSchedule(pid interface{}, schedule
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#create-a-new-pipeline-schedule
 type CreatePipelineScheduleVariableOptions struct {
-	Key          *string `url:"key" json:"key"`
-	Value        *string `url:"value" json:"value"`
-	VariableType *string `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Key          *string            `url:"key" json:"key"`
+	Value        *string            `url:"value" json:"value"`
+	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
 // CreatePipelineScheduleVariable creates a pipeline schedule variable.
@@ -291,11 +292,11 @@ func (s *PipelineSchedulesService) CreatePipelineScheduleVariable(pid interface{
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#edit-a-pipeline-schedule-variable
 type EditPipelineScheduleVariableOptions struct {
-	Value        *string `url:"value" json:"value"`
-	VariableType *string `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Value        *string            `url:"value" json:"value"`
+	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// EditPipelineScheduleVariable creates a pipeline schedule variable.
+// EditPipelineScheduleVariable edits a pipeline schedule variable.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#edit-a-pipeline-schedule-variable
@@ -304,7 +305,7 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, url.PathEscape(key))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -320,7 +321,7 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	return p, resp, err
 }
 
-// DeletePipelineScheduleVariable creates a pipeline schedule variable.
+// DeletePipelineScheduleVariable deletes a pipeline schedule variable.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#delete-a-pipeline-schedule-variable
@@ -329,7 +330,7 @@ func (s *PipelineSchedulesService) DeletePipelineScheduleVariable(pid interface{
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, url.PathEscape(key))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -26,3 +27,85 @@ func TestRunPipelineSchedule(t *testing.T) {
 		t.Errorf("PipelineSchedules.RunPipelineSchedule returned status %v, want %v", res.StatusCode, http.StatusCreated)
 	}
 }
+
+func TestGetPipelineSchedule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":13,"description":"Nightly build","ref":"master","cron":"0 1 * * *","cron_timezone":"UTC","active":true,"last_pipeline":{"id":332,"sha":"0e788619d0b5ec17388dffb973ecd505946156db","ref":"master","status":"pending"},"variables":[{"key":"TEST_VARIABLE_1","variable_type":"env_var","value":"TEST_1"}]}`)
+	})
+
+	schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(1, 13)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.GetPipelineSchedule returned error: %v", err)
+	}
+
+	want := &PipelineSchedule{
+		ID:           13,
+		Description:  "Nightly build",
+		Ref:          "master",
+		Cron:         "0 1 * * *",
+		CronTimezone: "UTC",
+		Active:       true,
+		Variables: []*PipelineVariable{
+			{Key: "TEST_VARIABLE_1", Value: "TEST_1", VariableType: EnvVariableType},
+		},
+	}
+	want.LastPipeline.ID = 332
+	want.LastPipeline.SHA = "0e788619d0b5ec17388dffb973ecd505946156db"
+	want.LastPipeline.Ref = "master"
+	want.LastPipeline.Status = "pending"
+
+	if !reflect.DeepEqual(want, schedule) {
+		t.Errorf("PipelineSchedules.GetPipelineSchedule returned %+v, want %+v", schedule, want)
+	}
+}
+
+func TestTakeOwnershipOfPipelineSchedule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/take_ownership", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":13,"owner":{"id":2,"username":"john"}}`)
+	})
+
+	schedule, _, err := client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(1, 13)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.TakeOwnershipOfPipelineSchedule returned error: %v", err)
+	}
+
+	want := &PipelineSchedule{ID: 13, Owner: &User{ID: 2, Username: "john"}}
+	if !reflect.DeepEqual(want, schedule) {
+		t.Errorf("PipelineSchedules.TakeOwnershipOfPipelineSchedule returned %+v, want %+v", schedule, want)
+	}
+}
+
+func TestCreatePipelineScheduleVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"NEW_VARIABLE","value":"new value","variable_type":"file"}`)
+		fmt.Fprint(w, `{"key":"NEW_VARIABLE","variable_type":"file","value":"new value"}`)
+	})
+
+	opt := &CreatePipelineScheduleVariableOptions{
+		Key:          String("NEW_VARIABLE"),
+		Value:        String("new value"),
+		VariableType: VariableType(FileVariableType),
+	}
+
+	variable, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(1, 13, opt)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.CreatePipelineScheduleVariable returned error: %v", err)
+	}
+
+	want := &PipelineVariable{Key: "NEW_VARIABLE", Value: "new value", VariableType: FileVariableType}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("PipelineSchedules.CreatePipelineScheduleVariable returned %+v, want %+v", variable, want)
+	}
+}
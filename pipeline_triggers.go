@@ -2,7 +2,10 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 // PipelineTriggersService handles Project pipeline triggers.
@@ -202,10 +205,11 @@ func (s *PipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger
 type RunPipelineTriggerOptions struct {
 	Ref       *string           `url:"ref" json:"ref"`
 	Token     *string           `url:"token" json:"token"`
-	Variables map[string]string `url:"variables,omitempty" json:"variables,omitempty"`
+	Variables map[string]string `url:"-" json:"variables,omitempty"`
 }
 
-// RunPipelineTrigger starts a trigger from a project.
+// RunPipelineTrigger starts a trigger from a project. The options are sent
+// form encoded, with each variable encoded as a variables[KEY]=value field.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/ci/triggers/README.html#triggering-a-pipeline
@@ -216,7 +220,18 @@ func (s *PipelineTriggersService) RunPipelineTrigger(pid interface{}, opt *RunPi
 	}
 	u := fmt.Sprintf("projects/%s/trigger/pipeline", pathEscape(project))
 
-	req, err := s.client.NewRequest("POST", u, opt, options)
+	form := url.Values{}
+	if opt != nil {
+		form, err = query.Values(opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		for k, v := range opt.Variables {
+			form.Set(fmt.Sprintf("variables[%s]", k), v)
+		}
+	}
+
+	req, err := s.client.NewRequest("POST", u, form, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -28,3 +28,35 @@ func TestRunPipeline(t *testing.T) {
 		t.Errorf("PipelineTriggers.RunPipelineTrigger returned %+v, want %+v", pipeline, want)
 	}
 }
+
+func TestRunPipelineWithVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/trigger/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("Request Content-Type: %s, want application/x-www-form-urlencoded", got)
+		}
+		testBody(t, r, "ref=master&token=secret&variables%5BDEPLOY_ENV%5D=staging&variables%5BRELEASE%5D=v1.2.3")
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &RunPipelineTriggerOptions{
+		Ref:   String("master"),
+		Token: String("secret"),
+		Variables: map[string]string{
+			"DEPLOY_ENV": "staging",
+			"RELEASE":    "v1.2.3",
+		},
+	}
+	pipeline, _, err := client.PipelineTriggers.RunPipelineTrigger(1, opt)
+	if err != nil {
+		t.Errorf("PipelineTriggers.RunPipelineTrigger returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 1, Status: "pending"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("PipelineTriggers.RunPipelineTrigger returned %+v, want %+v", pipeline, want)
+	}
+}
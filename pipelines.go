@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -33,32 +34,36 @@ type PipelinesService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html
 type PipelineVariable struct {
-	Key          string `json:"key"`
-	Value        string `json:"value"`
-	VariableType string `json:"variable_type"`
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type"`
 }
 
 // Pipeline represents a GitLab pipeline.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html
 type Pipeline struct {
-	ID             int             `json:"id"`
-	Status         string          `json:"status"`
-	Ref            string          `json:"ref"`
-	SHA            string          `json:"sha"`
-	BeforeSHA      string          `json:"before_sha"`
-	Tag            bool            `json:"tag"`
-	YamlErrors     string          `json:"yaml_errors"`
-	User           *BasicUser      `json:"user"`
-	UpdatedAt      *time.Time      `json:"updated_at"`
-	CreatedAt      *time.Time      `json:"created_at"`
-	StartedAt      *time.Time      `json:"started_at"`
-	FinishedAt     *time.Time      `json:"finished_at"`
-	CommittedAt    *time.Time      `json:"committed_at"`
-	Duration       int             `json:"duration"`
-	Coverage       string          `json:"coverage"`
-	WebURL         string          `json:"web_url"`
-	DetailedStatus *DetailedStatus `json:"detailed_status"`
+	ID             int                 `json:"id"`
+	IID            int                 `json:"iid"`
+	ProjectID      int                 `json:"project_id"`
+	Status         string              `json:"status"`
+	Source         PipelineSourceValue `json:"source"`
+	Ref            string              `json:"ref"`
+	SHA            string              `json:"sha"`
+	BeforeSHA      string              `json:"before_sha"`
+	Tag            bool                `json:"tag"`
+	YamlErrors     string              `json:"yaml_errors"`
+	User           *BasicUser          `json:"user"`
+	UpdatedAt      *time.Time          `json:"updated_at"`
+	CreatedAt      *time.Time          `json:"created_at"`
+	StartedAt      *time.Time          `json:"started_at"`
+	FinishedAt     *time.Time          `json:"finished_at"`
+	CommittedAt    *time.Time          `json:"committed_at"`
+	Duration       int                 `json:"duration"`
+	QueuedDuration float64             `json:"queued_duration"`
+	Coverage       string              `json:"coverage"`
+	WebURL         string              `json:"web_url"`
+	DetailedStatus *DetailedStatus     `json:"detailed_status"`
 }
 
 // DetailedStatus contains detailed information about the status of a pipeline.
@@ -80,9 +85,18 @@ func (p Pipeline) String() string {
 	return Stringify(p)
 }
 
+// CoverageFloat parses the coverage of the pipeline, which GitLab returns
+// as a string, into a float64. It returns 0 if no coverage was reported.
+func (p Pipeline) CoverageFloat() (float64, error) {
+	if p.Coverage == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(p.Coverage, 64)
+}
+
 // PipelineTestReport contains a detailed report of a test run.
 type PipelineTestReport struct {
-	TotalTime    int                  `json:"total_time"`
+	TotalTime    float64              `json:"total_time"`
 	TotalCount   int                  `json:"total_count"`
 	SuccessCount int                  `json:"success_count"`
 	FailedCount  int                  `json:"failed_count"`
@@ -94,39 +108,84 @@ type PipelineTestReport struct {
 // PipelineTestSuites contains test suites results.
 type PipelineTestSuites struct {
 	Name         string              `json:"name"`
-	TotalTime    int                 `json:"total_time"`
+	TotalTime    float64             `json:"total_time"`
 	TotalCount   int                 `json:"total_count"`
 	SuccessCount int                 `json:"success_count"`
 	FailedCount  int                 `json:"failed_count"`
 	SkippedCount int                 `json:"skipped_count"`
 	ErrorCount   int                 `json:"error_count"`
+	SuiteError   string              `json:"suite_error"`
 	TestCases    []PipelineTestCases `json:"test_cases"`
 }
 
 // PipelineTestCases contains test cases details.
 type PipelineTestCases struct {
-	Status        string `json:"status"`
-	Name          string `json:"name"`
-	Classname     string `json:"classname"`
-	ExecutionTime int    `json:"execution_time"`
-	SystemOutput  string `json:"system_output"`
-	StackTrace    string `json:"stack_trace"`
+	Status         string  `json:"status"`
+	Name           string  `json:"name"`
+	Classname      string  `json:"classname"`
+	File           string  `json:"file"`
+	ExecutionTime  float64 `json:"execution_time"`
+	SystemOutput   string  `json:"system_output"`
+	StackTrace     string  `json:"stack_trace"`
+	AttachmentURL  string  `json:"attachment_url"`
+	RecentFailures *struct {
+		Count      int    `json:"count"`
+		BaseBranch string `json:"base_branch"`
+	} `json:"recent_failures"`
 }
 
 func (p PipelineTestReport) String() string {
 	return Stringify(p)
 }
 
+// PipelineTestReportSummary contains a summary of the test report of a
+// pipeline.
+type PipelineTestReportSummary struct {
+	Total      PipelineTotalSummary       `json:"total"`
+	TestSuites []PipelineTestSuiteSummary `json:"test_suites"`
+}
+
+// PipelineTotalSummary contains the total counts of a test report summary.
+type PipelineTotalSummary struct {
+	Time       float64 `json:"time"`
+	Count      int     `json:"count"`
+	Success    int     `json:"success"`
+	Failed     int     `json:"failed"`
+	Skipped    int     `json:"skipped"`
+	Error      int     `json:"error"`
+	SuiteError string  `json:"suite_error"`
+}
+
+// PipelineTestSuiteSummary contains the summary of a single test suite.
+type PipelineTestSuiteSummary struct {
+	Name         string  `json:"name"`
+	TotalTime    float64 `json:"total_time"`
+	TotalCount   int     `json:"total_count"`
+	SuccessCount int     `json:"success_count"`
+	FailedCount  int     `json:"failed_count"`
+	SkippedCount int     `json:"skipped_count"`
+	ErrorCount   int     `json:"error_count"`
+	BuildIDs     []int   `json:"build_ids"`
+	SuiteError   string  `json:"suite_error"`
+}
+
+func (p PipelineTestReportSummary) String() string {
+	return Stringify(p)
+}
+
 // PipelineInfo shows the basic entities of a pipeline, mostly used as fields
 // on other assets, like Commit.
 type PipelineInfo struct {
-	ID        int        `json:"id"`
-	Status    string     `json:"status"`
-	Ref       string     `json:"ref"`
-	SHA       string     `json:"sha"`
-	WebURL    string     `json:"web_url"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID        int                 `json:"id"`
+	IID       int                 `json:"iid"`
+	ProjectID int                 `json:"project_id"`
+	Status    string              `json:"status"`
+	Source    PipelineSourceValue `json:"source"`
+	Ref       string              `json:"ref"`
+	SHA       string              `json:"sha"`
+	WebURL    string              `json:"web_url"`
+	UpdatedAt *time.Time          `json:"updated_at"`
+	CreatedAt *time.Time          `json:"created_at"`
 }
 
 func (p PipelineInfo) String() string {
@@ -138,17 +197,18 @@ func (p PipelineInfo) String() string {
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
 type ListProjectPipelinesOptions struct {
 	ListOptions
-	Scope         *string          `url:"scope,omitempty" json:"scope,omitempty"`
-	Status        *BuildStateValue `url:"status,omitempty" json:"status,omitempty"`
-	Ref           *string          `url:"ref,omitempty" json:"ref,omitempty"`
-	SHA           *string          `url:"sha,omitempty" json:"sha,omitempty"`
-	YamlErrors    *bool            `url:"yaml_errors,omitempty" json:"yaml_errors,omitempty"`
-	Name          *string          `url:"name,omitempty" json:"name,omitempty"`
-	Username      *string          `url:"username,omitempty" json:"username,omitempty"`
-	UpdatedAfter  *time.Time       `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore *time.Time       `url:"updated_before,omitempty" json:"updated_before,omitempty"`
-	OrderBy       *string          `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort          *string          `url:"sort,omitempty" json:"sort,omitempty"`
+	Scope         *string              `url:"scope,omitempty" json:"scope,omitempty"`
+	Status        *BuildStateValue     `url:"status,omitempty" json:"status,omitempty"`
+	Source        *PipelineSourceValue `url:"source,omitempty" json:"source,omitempty"`
+	Ref           *string              `url:"ref,omitempty" json:"ref,omitempty"`
+	SHA           *string              `url:"sha,omitempty" json:"sha,omitempty"`
+	YamlErrors    *bool                `url:"yaml_errors,omitempty" json:"yaml_errors,omitempty"`
+	Name          *string              `url:"name,omitempty" json:"name,omitempty"`
+	Username      *string              `url:"username,omitempty" json:"username,omitempty"`
+	UpdatedAfter  *time.Time           `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore *time.Time           `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	OrderBy       *string              `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort          *string              `url:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ListProjectPipelines gets a list of project piplines.
@@ -226,14 +286,14 @@ func (s *PipelinesService) GetPipelineVariables(pid interface{}, pipeline int, o
 // GetPipelineTestReport gets the test report of a single project pipeline.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report
-func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int) (*PipelineTestReport, *Response, error) {
+func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReport, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/pipelines/%d/test_report", pathEscape(project), pipeline)
 
-	req, err := s.client.NewRequest("GET", u, nil, nil)
+	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -247,6 +307,32 @@ func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int)
 	return p, resp, err
 }
 
+// GetPipelineTestReportSummary gets the test report summary of a single
+// project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report-summary
+func (s *PipelinesService) GetPipelineTestReportSummary(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReportSummary, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/test_report_summary", pathEscape(project), pipeline)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(PipelineTestReportSummary)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
 // CreatePipelineOptions represents the available CreatePipeline() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#create-a-new-pipeline
@@ -279,7 +365,8 @@ func (s *PipelinesService) CreatePipeline(pid interface{}, opt *CreatePipelineOp
 	return p, resp, err
 }
 
-// RetryPipelineBuild retries failed builds in a pipeline
+// RetryPipelineBuild retries failed builds in a pipeline. Only failed or
+// canceled jobs are restarted.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipelines.html#retry-failed-builds-in-a-pipeline
@@ -304,10 +391,11 @@ func (s *PipelinesService) RetryPipelineBuild(pid interface{}, pipeline int, opt
 	return p, resp, err
 }
 
-// CancelPipelineBuild cancels a pipeline builds
+// CancelPipelineBuild cancels a pipeline builds. All running and pending jobs
+// of the pipeline are transitioned to canceled.
 //
 // GitLab API docs:
-//https://docs.gitlab.com/ce/api/pipelines.html#cancel-a-pipelines-builds
+// https://docs.gitlab.com/ce/api/pipelines.html#cancel-a-pipelines-builds
 func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
@@ -329,7 +417,8 @@ func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, op
 	return p, resp, err
 }
 
-// DeletePipeline deletes an existing pipeline.
+// DeletePipeline deletes an existing pipeline, including all of its jobs,
+// artifacts and traces.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipelines.html#delete-a-pipeline
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListProjectPipelines(t *testing.T) {
@@ -28,6 +29,46 @@ func TestListProjectPipelines(t *testing.T) {
 	}
 }
 
+func TestListProjectPipelinesWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/pipelines?order_by=updated_at&scope=finished&sort=desc&source=schedule&status=failed&updated_after=2020-01-02T03%3A04%3A05Z&updated_before=2020-02-03T04%3A05%3A06Z")
+		fmt.Fprint(w, `[{"id":1,"iid":7,"project_id":1,"status":"failed","source":"schedule","ref":"master"}]`)
+	})
+
+	after := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	before := time.Date(2020, time.February, 3, 4, 5, 6, 0, time.UTC)
+
+	opt := &ListProjectPipelinesOptions{
+		Scope:         String("finished"),
+		Status:        BuildState(Failed),
+		Source:        PipelineSource(SchedulePipelineSource),
+		UpdatedAfter:  &after,
+		UpdatedBefore: &before,
+		OrderBy:       String("updated_at"),
+		Sort:          String("desc"),
+	}
+	piplines, _, err := client.Pipelines.ListProjectPipelines(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.ListProjectPipelines returned error: %v", err)
+	}
+
+	want := []*PipelineInfo{{
+		ID:        1,
+		IID:       7,
+		ProjectID: 1,
+		Status:    "failed",
+		Source:    SchedulePipelineSource,
+		Ref:       "master",
+	}}
+	if !reflect.DeepEqual(want, piplines) {
+		t.Errorf("Pipelines.ListProjectPipelines returned %+v, want %+v", piplines, want)
+	}
+}
+
 func TestGetPipeline(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -48,6 +89,90 @@ func TestGetPipeline(t *testing.T) {
 	}
 }
 
+func TestGetPipelineWithDetails(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/46", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		mustWriteHTTPResponse(t, w, "testdata/get_pipeline.json")
+	})
+
+	pipeline, _, err := client.Pipelines.GetPipeline(1, 46)
+	if err != nil {
+		t.Fatalf("Pipelines.GetPipeline returned error: %v", err)
+	}
+
+	createdAt := time.Date(2016, 8, 11, 11, 28, 34, 85000000, time.UTC)
+	startedAt := time.Date(2016, 8, 11, 11, 28, 56, 85000000, time.UTC)
+	finishedAt := time.Date(2016, 8, 11, 11, 32, 35, 145000000, time.UTC)
+	want := &Pipeline{
+		ID:             46,
+		IID:            11,
+		ProjectID:      1,
+		Status:         "success",
+		Source:         PushPipelineSource,
+		Ref:            "main",
+		SHA:            "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		BeforeSHA:      "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Tag:            false,
+		User:           &BasicUser{ID: 1, Name: "Administrator", Username: "root", State: "active"},
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &createdAt,
+		StartedAt:      &startedAt,
+		FinishedAt:     &finishedAt,
+		Duration:       219,
+		QueuedDuration: 0.01,
+		Coverage:       "30.0",
+		WebURL:         "https://example.com/foo/bar/pipelines/46",
+		DetailedStatus: &DetailedStatus{
+			Icon:        "status_success",
+			Text:        "passed",
+			Label:       "passed",
+			Group:       "success",
+			Tooltip:     "passed",
+			HasDetails:  true,
+			DetailsPath: "/foo/bar/-/pipelines/46",
+			Favicon:     "/assets/ci_favicons/favicon_status_success.png",
+		},
+	}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.GetPipeline returned %+v, want %+v", pipeline, want)
+	}
+
+	coverage, err := pipeline.CoverageFloat()
+	if err != nil {
+		t.Fatalf("Pipeline.CoverageFloat returned error: %v", err)
+	}
+	if coverage != 30.0 {
+		t.Errorf("Pipeline.CoverageFloat returned %v, want %v", coverage, 30.0)
+	}
+}
+
+func TestPipelineCoverageFloat(t *testing.T) {
+	tests := []struct {
+		coverage string
+		want     float64
+		wantErr  bool
+	}{
+		{coverage: "", want: 0},
+		{coverage: "86.5", want: 86.5},
+		{coverage: "100", want: 100},
+		{coverage: "n/a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Pipeline{Coverage: tt.coverage}.CoverageFloat()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Pipeline{Coverage: %q}.CoverageFloat() error = %v, wantErr %v", tt.coverage, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Pipeline{Coverage: %q}.CoverageFloat() = %v, want %v", tt.coverage, got, tt.want)
+		}
+	}
+}
+
 func TestGetPipelineVariables(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -111,6 +236,41 @@ func TestGetPipelineTestReport(t *testing.T) {
 	}
 }
 
+func TestGetPipelineTestReportSummary(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/123456/test_report_summary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		mustWriteHTTPResponse(t, w, "testdata/get_pipeline_testreport_summary.json")
+	})
+
+	summary, _, err := client.Pipelines.GetPipelineTestReportSummary(1, 123456)
+	if err != nil {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned error: %v", err)
+	}
+
+	want := &PipelineTestReportSummary{
+		Total: PipelineTotalSummary{
+			Time:    1904.5,
+			Count:   3363,
+			Success: 3351,
+			Skipped: 12,
+		},
+		TestSuites: []PipelineTestSuiteSummary{{
+			Name:         "test",
+			TotalTime:    1904.5,
+			TotalCount:   3363,
+			SuccessCount: 3351,
+			SkippedCount: 12,
+			BuildIDs:     []int{66004},
+		}},
+	}
+	if !reflect.DeepEqual(want, summary) {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned %+v, want %+v", summary, want)
+	}
+}
+
 func TestCreatePipeline(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -133,6 +293,34 @@ func TestCreatePipeline(t *testing.T) {
 	}
 }
 
+func TestCreatePipelineWithVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"master","variables":[{"key":"BUILD_ID","value":"42","variable_type":"env_var"},{"key":"CONFIG","value":"foo: bar","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &CreatePipelineOptions{
+		Ref: String("master"),
+		Variables: []*PipelineVariable{
+			{Key: "BUILD_ID", Value: "42", VariableType: EnvVariableType},
+			{Key: "CONFIG", Value: "foo: bar", VariableType: FileVariableType},
+		},
+	}
+	pipeline, _, err := client.Pipelines.CreatePipeline(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.CreatePipeline returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 1, Status: "pending"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.CreatePipeline returned %+v, want %+v", pipeline, want)
+	}
+}
+
 func TestRetryPipelineBuild(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -153,6 +341,33 @@ func TestRetryPipelineBuild(t *testing.T) {
 	}
 }
 
+func TestRetryPipelineBuildForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/5949167/retry", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, resp, err := client.Pipelines.RetryPipelineBuild(1, 5949167)
+	if err == nil {
+		t.Fatal("Pipelines.RetryPipelineBuild expected an error, got nil")
+	}
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Pipelines.RetryPipelineBuild returned error type %T, want *ErrorResponse", err)
+	}
+	if errResp.Message != "{message: 403 Forbidden}" {
+		t.Errorf("Pipelines.RetryPipelineBuild returned message %q, want %q", errResp.Message, "{message: 403 Forbidden}")
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Pipelines.RetryPipelineBuild returned status %d, want %d", resp.StatusCode, http.StatusForbidden)
+	}
+}
+
 func TestCancelPipelineBuild(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -179,10 +394,34 @@ func TestDeletePipeline(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/pipelines/5949167", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
 	})
 
-	_, err := client.Pipelines.DeletePipeline("1", 5949167)
+	resp, err := client.Pipelines.DeletePipeline("1", 5949167)
 	if err != nil {
 		t.Errorf("Pipelines.DeletePipeline returned error: %v", err)
 	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Pipelines.DeletePipeline returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDeletePipelineForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/5949167", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, err := client.Pipelines.DeletePipeline("1", 5949167)
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Pipelines.DeletePipeline returned error %v, want *ErrorResponse", err)
+	}
+	if errResp.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("Pipelines.DeletePipeline returned status %d, want %d", errResp.Response.StatusCode, http.StatusForbidden)
+	}
 }
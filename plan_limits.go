@@ -0,0 +1,123 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+// PlanLimitsService handles communication with the plan limits related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/plan_limits.html
+type PlanLimitsService struct {
+	client *Client
+}
+
+// PlanLimit represents the limits of a GitLab plan.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/plan_limits.html
+type PlanLimit struct {
+	CIPipelineSize             int   `json:"ci_pipeline_size"`
+	CIActiveJobs               int   `json:"ci_active_jobs"`
+	CIActivePipelines          int   `json:"ci_active_pipelines"`
+	CIProjectSubscriptions     int   `json:"ci_project_subscriptions"`
+	CIPipelineSchedules        int   `json:"ci_pipeline_schedules"`
+	CINeedsSizeLimit           int   `json:"ci_needs_size_limit"`
+	CIRegisteredGroupRunners   int   `json:"ci_registered_group_runners"`
+	CIRegisteredProjectRunners int   `json:"ci_registered_project_runners"`
+	ConanMaxFileSize           int64 `json:"conan_max_file_size"`
+	GenericPackagesMaxFileSize int64 `json:"generic_packages_max_file_size"`
+	HelmMaxFileSize            int64 `json:"helm_max_file_size"`
+	MavenMaxFileSize           int64 `json:"maven_max_file_size"`
+	NPMMaxFileSize             int64 `json:"npm_max_file_size"`
+	NugetMaxFileSize           int64 `json:"nuget_max_file_size"`
+	PyPiMaxFileSize            int64 `json:"pypi_max_file_size"`
+	TerraformModuleMaxFileSize int64 `json:"terraform_module_max_file_size"`
+	StorageSizeLimit           int64 `json:"storage_size_limit"`
+}
+
+// GetCurrentPlanLimitsOptions represents the available GetCurrentPlanLimits()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/plan_limits.html#get-current-plan-limits
+type GetCurrentPlanLimitsOptions struct {
+	PlanName *string `url:"plan_name,omitempty" json:"plan_name,omitempty"`
+}
+
+// GetCurrentPlanLimits lists the current limits of a plan on the GitLab
+// instance. If no plan name is given, the limits of the default plan are
+// returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/plan_limits.html#get-current-plan-limits
+func (s *PlanLimitsService) GetCurrentPlanLimits(opt *GetCurrentPlanLimitsOptions, options ...RequestOptionFunc) (*PlanLimit, *Response, error) {
+	req, err := s.client.NewRequest("GET", "application/plan_limits", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pl := new(PlanLimit)
+	resp, err := s.client.Do(req, pl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pl, resp, err
+}
+
+// ChangePlanLimitOptions represents the available ChangePlanLimits() options.
+// Limits that are not set are left untouched.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/plan_limits.html#change-plan-limits
+type ChangePlanLimitOptions struct {
+	PlanName                   *string `url:"plan_name,omitempty" json:"plan_name,omitempty"`
+	CIPipelineSize             *int    `url:"ci_pipeline_size,omitempty" json:"ci_pipeline_size,omitempty"`
+	CIActiveJobs               *int    `url:"ci_active_jobs,omitempty" json:"ci_active_jobs,omitempty"`
+	CIActivePipelines          *int    `url:"ci_active_pipelines,omitempty" json:"ci_active_pipelines,omitempty"`
+	CIProjectSubscriptions     *int    `url:"ci_project_subscriptions,omitempty" json:"ci_project_subscriptions,omitempty"`
+	CIPipelineSchedules        *int    `url:"ci_pipeline_schedules,omitempty" json:"ci_pipeline_schedules,omitempty"`
+	CINeedsSizeLimit           *int    `url:"ci_needs_size_limit,omitempty" json:"ci_needs_size_limit,omitempty"`
+	CIRegisteredGroupRunners   *int    `url:"ci_registered_group_runners,omitempty" json:"ci_registered_group_runners,omitempty"`
+	CIRegisteredProjectRunners *int    `url:"ci_registered_project_runners,omitempty" json:"ci_registered_project_runners,omitempty"`
+	ConanMaxFileSize           *int64  `url:"conan_max_file_size,omitempty" json:"conan_max_file_size,omitempty"`
+	GenericPackagesMaxFileSize *int64  `url:"generic_packages_max_file_size,omitempty" json:"generic_packages_max_file_size,omitempty"`
+	HelmMaxFileSize            *int64  `url:"helm_max_file_size,omitempty" json:"helm_max_file_size,omitempty"`
+	MavenMaxFileSize           *int64  `url:"maven_max_file_size,omitempty" json:"maven_max_file_size,omitempty"`
+	NPMMaxFileSize             *int64  `url:"npm_max_file_size,omitempty" json:"npm_max_file_size,omitempty"`
+	NugetMaxFileSize           *int64  `url:"nuget_max_file_size,omitempty" json:"nuget_max_file_size,omitempty"`
+	PyPiMaxFileSize            *int64  `url:"pypi_max_file_size,omitempty" json:"pypi_max_file_size,omitempty"`
+	TerraformModuleMaxFileSize *int64  `url:"terraform_module_max_file_size,omitempty" json:"terraform_module_max_file_size,omitempty"`
+	StorageSizeLimit           *int64  `url:"storage_size_limit,omitempty" json:"storage_size_limit,omitempty"`
+}
+
+// ChangePlanLimits modifies the limits of a plan on the GitLab instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/plan_limits.html#change-plan-limits
+func (s *PlanLimitsService) ChangePlanLimits(opt *ChangePlanLimitOptions, options ...RequestOptionFunc) (*PlanLimit, *Response, error) {
+	req, err := s.client.NewRequest("PUT", "application/plan_limits", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pl := new(PlanLimit)
+	resp, err := s.client.Do(req, pl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pl, resp, err
+}
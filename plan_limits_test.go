@@ -0,0 +1,69 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetCurrentPlanLimits(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/application/plan_limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/application/plan_limits?plan_name=premium")
+		fmt.Fprint(w, `{
+			"ci_pipeline_size": 0,
+			"ci_active_jobs": 500,
+			"ci_project_subscriptions": 2,
+			"conan_max_file_size": 3221225472,
+			"generic_packages_max_file_size": 5368709120,
+			"maven_max_file_size": 3221225472,
+			"npm_max_file_size": 524288000
+		}`)
+	})
+
+	limits, _, err := client.PlanLimits.GetCurrentPlanLimits(&GetCurrentPlanLimitsOptions{PlanName: String("premium")})
+	if err != nil {
+		t.Fatalf("PlanLimits.GetCurrentPlanLimits returned error: %v", err)
+	}
+
+	want := &PlanLimit{
+		CIActiveJobs:               500,
+		CIProjectSubscriptions:     2,
+		ConanMaxFileSize:           3221225472,
+		GenericPackagesMaxFileSize: 5368709120,
+		MavenMaxFileSize:           3221225472,
+		NPMMaxFileSize:             524288000,
+	}
+	if !reflect.DeepEqual(want, limits) {
+		t.Errorf("PlanLimits.GetCurrentPlanLimits returned %+v, want %+v", limits, want)
+	}
+}
+
+func TestChangePlanLimits(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/application/plan_limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"plan_name":"default","generic_packages_max_file_size":10737418240}`)
+		fmt.Fprint(w, `{"ci_active_jobs": 500, "generic_packages_max_file_size": 10737418240}`)
+	})
+
+	opt := &ChangePlanLimitOptions{
+		PlanName:                   String("default"),
+		GenericPackagesMaxFileSize: Int64(10737418240),
+	}
+	limits, _, err := client.PlanLimits.ChangePlanLimits(opt)
+	if err != nil {
+		t.Fatalf("PlanLimits.ChangePlanLimits returned error: %v", err)
+	}
+
+	want := &PlanLimit{CIActiveJobs: 500, GenericPackagesMaxFileSize: 10737418240}
+	if !reflect.DeepEqual(want, limits) {
+		t.Errorf("PlanLimits.ChangePlanLimits returned %+v, want %+v", limits, want)
+	}
+}
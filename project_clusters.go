@@ -177,6 +177,8 @@ func (s *ProjectClustersService) AddCluster(pid interface{}, opt *AddClusterOpti
 type EditClusterOptions struct {
 	Name                *string                        `url:"name,omitempty" json:"name,omitempty"`
 	Domain              *string                        `url:"domain,omitempty" json:"domain,omitempty"`
+	Enabled             *bool                          `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Managed             *bool                          `url:"managed,omitempty" json:"managed,omitempty"`
 	EnvironmentScope    *string                        `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 	ManagementProjectID *string                        `url:"management_project_id,omitempty" json:"management_project_id,omitempty"`
 	PlatformKubernetes  *EditPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
@@ -0,0 +1,224 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProjectRepositoryStorageMovesService handles communication with the
+// project repository storage moves related methods of the GitLab API.
+// These endpoints are only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type ProjectRepositoryStorageMovesService struct {
+	client *Client
+}
+
+// ProjectRepositoryStorageMove represents the status of a repository move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type ProjectRepositoryStorageMove struct {
+	ID                     int                             `json:"id"`
+	CreatedAt              *time.Time                      `json:"created_at"`
+	State                  RepositoryStorageMoveStateValue `json:"state"`
+	SourceStorageName      string                          `json:"source_storage_name"`
+	DestinationStorageName string                          `json:"destination_storage_name"`
+	Project                *RepositoryProject              `json:"project"`
+}
+
+func (p ProjectRepositoryStorageMove) String() string {
+	return Stringify(p)
+}
+
+// RepositoryProject represents the project a repository storage move
+// belongs to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type RepositoryProject struct {
+	ID                int        `json:"id"`
+	Description       string     `json:"description"`
+	Name              string     `json:"name"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	Path              string     `json:"path"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	CreatedAt         *time.Time `json:"created_at"`
+}
+
+// ListProjectRepositoryStorageMovesOptions represents the available
+// ListStorageMoves() and ListStorageMovesForProject() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type ListProjectRepositoryStorageMovesOptions ListOptions
+
+// ListStorageMoves gets all project repository storage moves.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
+func (s *ProjectRepositoryStorageMovesService) ListStorageMoves(opt *ListProjectRepositoryStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	req, err := s.client.NewRequest("GET", "project_repository_storage_moves", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var psms []*ProjectRepositoryStorageMove
+	resp, err := s.client.Do(req, &psms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return psms, resp, err
+}
+
+// ListStorageMovesForProject gets all repository storage moves for a single
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-project
+func (s *ProjectRepositoryStorageMovesService) ListStorageMovesForProject(pid interface{}, opt *ListProjectRepositoryStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var psms []*ProjectRepositoryStorageMove
+	resp, err := s.client.Do(req, &psms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return psms, resp, err
+}
+
+// GetStorageMove gets a single project repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-project-repository-storage-move
+func (s *ProjectRepositoryStorageMovesService) GetStorageMove(storage int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("project_repository_storage_moves/%d", storage)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	psm := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, psm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return psm, resp, err
+}
+
+// GetStorageMoveForProject gets a single repository storage move for a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-repository-storage-move-for-a-project
+func (s *ProjectRepositoryStorageMovesService) GetStorageMoveForProject(pid interface{}, storage int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves/%d", pathEscape(project), storage)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	psm := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, psm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return psm, resp, err
+}
+
+// ScheduleStorageMoveForProjectOptions represents the available
+// ScheduleStorageMoveForProject() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+type ScheduleStorageMoveForProjectOptions struct {
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleStorageMoveForProject schedules a repository to be moved for a
+// project. When no destination storage is given, GitLab picks one based on
+// the storage weights.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+func (s *ProjectRepositoryStorageMovesService) ScheduleStorageMoveForProject(pid interface{}, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	psm := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, psm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return psm, resp, err
+}
+
+// ScheduleAllStorageMovesOptions represents the available
+// ScheduleAllStorageMoves() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+type ScheduleAllStorageMovesOptions struct {
+	SourceStorageName      *string `url:"source_storage_name,omitempty" json:"source_storage_name,omitempty"`
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleAllStorageMoves schedules all repositories to be moved off of the
+// source storage shard. The moves are scheduled asynchronously, so GitLab
+// responds with 202 Accepted.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+func (s *ProjectRepositoryStorageMovesService) ScheduleAllStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest("POST", "project_repository_storage_moves", opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
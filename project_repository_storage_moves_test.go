@@ -0,0 +1,178 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListStorageMoves(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/project_repository_storage_moves?page=1&per_page=2")
+		fmt.Fprint(w, `[{"id":123,"state":"scheduled"},{"id":122,"state":"finished"}]`)
+	})
+
+	opt := &ListProjectRepositoryStorageMovesOptions{Page: 1, PerPage: 2}
+	moves, _, err := client.ProjectRepositoryStorageMoves.ListStorageMoves(opt)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.ListStorageMoves returned error: %v", err)
+	}
+
+	want := []*ProjectRepositoryStorageMove{
+		{ID: 123, State: RepositoryStorageMoveScheduled},
+		{ID: 122, State: RepositoryStorageMoveFinished},
+	}
+	if !reflect.DeepEqual(want, moves) {
+		t.Errorf("ProjectRepositoryStorageMoves.ListStorageMoves returned %+v, want %+v", moves, want)
+	}
+}
+
+func TestListStorageMovesForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":123,"state":"failed"}]`)
+	})
+
+	moves, _, err := client.ProjectRepositoryStorageMoves.ListStorageMovesForProject(1, nil)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.ListStorageMovesForProject returned error: %v", err)
+	}
+
+	want := []*ProjectRepositoryStorageMove{{ID: 123, State: RepositoryStorageMoveFailed}}
+	if !reflect.DeepEqual(want, moves) {
+		t.Errorf("ProjectRepositoryStorageMoves.ListStorageMovesForProject returned %+v, want %+v", moves, want)
+	}
+}
+
+func TestGetStorageMove(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 123,
+			"created_at": "2020-05-07T04:27:17.234Z",
+			"state": "scheduled",
+			"source_storage_name": "default",
+			"destination_storage_name": "storage2",
+			"project": {
+				"id": 1,
+				"description": null,
+				"name": "project1",
+				"name_with_namespace": "John Doe2 / project1",
+				"path": "project1",
+				"path_with_namespace": "namespace1/project1",
+				"created_at": "2020-05-07T04:27:17.016Z"
+			}
+		}`)
+	})
+
+	move, _, err := client.ProjectRepositoryStorageMoves.GetStorageMove(123)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.GetStorageMove returned error: %v", err)
+	}
+
+	createdAt := time.Date(2020, 5, 7, 4, 27, 17, 234000000, time.UTC)
+	projectCreatedAt := time.Date(2020, 5, 7, 4, 27, 17, 16000000, time.UTC)
+	want := &ProjectRepositoryStorageMove{
+		ID:                     123,
+		CreatedAt:              &createdAt,
+		State:                  RepositoryStorageMoveScheduled,
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Project: &RepositoryProject{
+			ID:                1,
+			Name:              "project1",
+			NameWithNamespace: "John Doe2 / project1",
+			Path:              "project1",
+			PathWithNamespace: "namespace1/project1",
+			CreatedAt:         &projectCreatedAt,
+		},
+	}
+	if !reflect.DeepEqual(want, move) {
+		t.Errorf("ProjectRepositoryStorageMoves.GetStorageMove returned %+v, want %+v", move, want)
+	}
+}
+
+func TestGetStorageMoveForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":123,"state":"started"}`)
+	})
+
+	move, _, err := client.ProjectRepositoryStorageMoves.GetStorageMoveForProject(1, 123)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.GetStorageMoveForProject returned error: %v", err)
+	}
+
+	want := &ProjectRepositoryStorageMove{ID: 123, State: RepositoryStorageMoveStarted}
+	if !reflect.DeepEqual(want, move) {
+		t.Errorf("ProjectRepositoryStorageMoves.GetStorageMoveForProject returned %+v, want %+v", move, want)
+	}
+}
+
+func TestScheduleStorageMoveForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":124,"state":"initial","source_storage_name":"default","destination_storage_name":"storage2"}`)
+	})
+
+	opt := &ScheduleStorageMoveForProjectOptions{DestinationStorageName: String("storage2")}
+	move, _, err := client.ProjectRepositoryStorageMoves.ScheduleStorageMoveForProject(1, opt)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.ScheduleStorageMoveForProject returned error: %v", err)
+	}
+
+	want := &ProjectRepositoryStorageMove{
+		ID:                     124,
+		State:                  RepositoryStorageMoveInitial,
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+	}
+	if !reflect.DeepEqual(want, move) {
+		t.Errorf("ProjectRepositoryStorageMoves.ScheduleStorageMoveForProject returned %+v, want %+v", move, want)
+	}
+}
+
+func TestScheduleAllStorageMoves(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"202 Accepted"}`)
+	})
+
+	opt := &ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	}
+	resp, err := client.ProjectRepositoryStorageMoves.ScheduleAllStorageMoves(opt)
+	if err != nil {
+		t.Fatalf("ProjectRepositoryStorageMoves.ScheduleAllStorageMoves returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("ProjectRepositoryStorageMoves.ScheduleAllStorageMoves returned status %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+}
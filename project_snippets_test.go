@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectSnippets(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":3,"title":"test","file_name":"add.rb"}]`)
+	})
+
+	snippets, _, err := client.ProjectSnippets.ListSnippets(1, nil)
+	if err != nil {
+		t.Fatalf("ProjectSnippets.ListSnippets returned error: %v", err)
+	}
+
+	want := []*Snippet{{ID: 3, Title: "test", FileName: "add.rb"}}
+	if !reflect.DeepEqual(want, snippets) {
+		t.Errorf("ProjectSnippets.ListSnippets returned %+v, want %+v", snippets, want)
+	}
+}
+
+func TestCreateProjectSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"test","visibility":"public","files":[{"file_path":"add.rb","content":"puts 1 + 1"}]}`)
+		fmt.Fprint(w, `{"id":3,"title":"test","file_name":"add.rb"}`)
+	})
+
+	opt := &CreateProjectSnippetOptions{
+		Title:      String("test"),
+		Visibility: Visibility(PublicVisibility),
+		Files: &[]*CreateSnippetFileOptions{
+			{FilePath: String("add.rb"), Content: String("puts 1 + 1")},
+		},
+	}
+	snippet, _, err := client.ProjectSnippets.CreateSnippet(1, opt)
+	if err != nil {
+		t.Fatalf("ProjectSnippets.CreateSnippet returned error: %v", err)
+	}
+
+	want := &Snippet{ID: 3, Title: "test", FileName: "add.rb"}
+	if !reflect.DeepEqual(want, snippet) {
+		t.Errorf("ProjectSnippets.CreateSnippet returned %+v, want %+v", snippet, want)
+	}
+}
+
+func TestProjectSnippetContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/3/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "puts 1 + 1")
+	})
+
+	content, _, err := client.ProjectSnippets.SnippetContent(1, 3)
+	if err != nil {
+		t.Fatalf("ProjectSnippets.SnippetContent returned error: %v", err)
+	}
+
+	if string(content) != "puts 1 + 1" {
+		t.Errorf("ProjectSnippets.SnippetContent returned %q, want %q", content, "puts 1 + 1")
+	}
+}
+
+func TestUpdateProjectSnippetFiles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"files":[{"action":"move","file_path":"sum.rb","previous_path":"add.rb"}]}`)
+		fmt.Fprint(w, `{"id":3,"title":"test","files":[{"path":"sum.rb","raw_url":"https://gitlab.example.com/group/project/-/snippets/3/raw/main/sum.rb"}]}`)
+	})
+
+	opt := &UpdateProjectSnippetOptions{
+		Files: &[]*UpdateSnippetFileOptions{{
+			Action:       SnippetFileAction(MoveSnippetFileAction),
+			FilePath:     String("sum.rb"),
+			PreviousPath: String("add.rb"),
+		}},
+	}
+	snippet, _, err := client.ProjectSnippets.UpdateSnippet(1, 3, opt)
+	if err != nil {
+		t.Fatalf("ProjectSnippets.UpdateSnippet returned error: %v", err)
+	}
+
+	if len(snippet.Files) != 1 || snippet.Files[0].Path != "sum.rb" {
+		t.Errorf("ProjectSnippets.UpdateSnippet returned files %+v, want a single sum.rb", snippet.Files)
+	}
+}
+
+func TestProjectGetSnippetFileRaw(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/3/files/v1.0/sum.rb/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "puts 1 + 1")
+	})
+
+	content, _, err := client.ProjectSnippets.GetSnippetFileRaw(1, 3, "v1.0", "sum.rb")
+	if err != nil {
+		t.Fatalf("ProjectSnippets.GetSnippetFileRaw returned error: %v", err)
+	}
+
+	if string(content) != "puts 1 + 1" {
+		t.Errorf("ProjectSnippets.GetSnippetFileRaw returned %q, want %q", content, "puts 1 + 1")
+	}
+}
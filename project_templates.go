@@ -0,0 +1,140 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ProjectTemplatesService handles communication with the project templates
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_templates.html
+type ProjectTemplatesService struct {
+	client *Client
+}
+
+// ProjectTemplateType represents the type of a project template.
+type ProjectTemplateType string
+
+// List of available project template types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_templates.html
+const (
+	DockerfilesProjectTemplate   ProjectTemplateType = "dockerfiles"
+	GitignoresProjectTemplate    ProjectTemplateType = "gitignores"
+	GitlabCIYMLsProjectTemplate  ProjectTemplateType = "gitlab_ci_ymls"
+	IssuesProjectTemplate        ProjectTemplateType = "issues"
+	LicensesProjectTemplate      ProjectTemplateType = "licenses"
+	MergeRequestsProjectTemplate ProjectTemplateType = "merge_requests"
+)
+
+// ProjectTemplate represents a template available in the context of a
+// project. Only license templates populate the license related fields.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_templates.html
+type ProjectTemplate struct {
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Nickname    string   `json:"nickname"`
+	Popular     bool     `json:"popular"`
+	HTMLURL     string   `json:"html_url"`
+	SourceURL   string   `json:"source_url"`
+	Description string   `json:"description"`
+	Conditions  []string `json:"conditions"`
+	Permissions []string `json:"permissions"`
+	Limitations []string `json:"limitations"`
+	Content     string   `json:"content"`
+}
+
+func (t ProjectTemplate) String() string {
+	return Stringify(t)
+}
+
+// ListProjectTemplatesOptions represents the available ListTemplates()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_templates.html#get-all-templates-of-a-particular-type
+type ListProjectTemplatesOptions ListOptions
+
+// ListTemplates gets a list of all templates of a particular type that are
+// available in the context of the project, including inherited group level
+// file templates.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_templates.html#get-all-templates-of-a-particular-type
+func (s *ProjectTemplatesService) ListTemplates(pid interface{}, templateType ProjectTemplateType, opt *ListProjectTemplatesOptions, options ...RequestOptionFunc) ([]*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s", pathEscape(project), templateType)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pt []*ProjectTemplate
+	resp, err := s.client.Do(req, &pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
+
+// GetProjectTemplateOptions represents the available GetTemplate() options.
+// The project and fullname options are only used to interpolate license
+// templates.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_templates.html#get-one-template-of-a-particular-type
+type GetProjectTemplateOptions struct {
+	SourceTemplateProjectID *int    `url:"source_template_project_id,omitempty" json:"source_template_project_id,omitempty"`
+	Project                 *string `url:"project,omitempty" json:"project,omitempty"`
+	Fullname                *string `url:"fullname,omitempty" json:"fullname,omitempty"`
+}
+
+// GetTemplate gets a single template of a particular type that is available
+// in the context of the project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_templates.html#get-one-template-of-a-particular-type
+func (s *ProjectTemplatesService) GetTemplate(pid interface{}, templateType ProjectTemplateType, name string, opt *GetProjectTemplateOptions, options ...RequestOptionFunc) (*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s/%s", pathEscape(project), templateType, url.PathEscape(name))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pt := new(ProjectTemplate)
+	resp, err := s.client.Do(req, pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
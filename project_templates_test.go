@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectTemplates(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/templates/issues?page=1&per_page=20")
+		fmt.Fprint(w, `[{"key":"bug","name":"bug"},{"key":"feature","name":"feature"}]`)
+	})
+
+	opt := &ListProjectTemplatesOptions{Page: 1, PerPage: 20}
+	templates, _, err := client.ProjectTemplates.ListTemplates(1, IssuesProjectTemplate, opt)
+	if err != nil {
+		t.Fatalf("ProjectTemplates.ListTemplates returned error: %v", err)
+	}
+
+	want := []*ProjectTemplate{{Key: "bug", Name: "bug"}, {Key: "feature", Name: "feature"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("ProjectTemplates.ListTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetProjectTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/gitlab_ci_ymls/Android", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"Android","content":"image: openjdk:8-jdk\n"}`)
+	})
+
+	template, _, err := client.ProjectTemplates.GetTemplate(1, GitlabCIYMLsProjectTemplate, "Android", nil)
+	if err != nil {
+		t.Fatalf("ProjectTemplates.GetTemplate returned error: %v", err)
+	}
+
+	want := &ProjectTemplate{Name: "Android", Content: "image: openjdk:8-jdk\n"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("ProjectTemplates.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
+
+func TestGetProjectLicenseTemplate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/templates/licenses/mit?fullname=Jane+Doe&project=my-project")
+		fmt.Fprint(w, `{"key":"mit","name":"MIT License","nickname":null,"popular":true,"permissions":["commercial-use"],"conditions":["include-copyright"],"limitations":["liability"],"content":"Copyright (c) 2021 Jane Doe\n"}`)
+	})
+
+	opt := &GetProjectTemplateOptions{
+		Project:  String("my-project"),
+		Fullname: String("Jane Doe"),
+	}
+	template, _, err := client.ProjectTemplates.GetTemplate(1, LicensesProjectTemplate, "mit", opt)
+	if err != nil {
+		t.Fatalf("ProjectTemplates.GetTemplate returned error: %v", err)
+	}
+
+	want := &ProjectTemplate{
+		Key:         "mit",
+		Name:        "MIT License",
+		Popular:     true,
+		Permissions: []string{"commercial-use"},
+		Conditions:  []string{"include-copyright"},
+		Limitations: []string{"liability"},
+		Content:     "Copyright (c) 2021 Jane Doe\n",
+	}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("ProjectTemplates.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
@@ -0,0 +1,105 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key":"TEST_VARIABLE_1","value":"test1","variable_type":"env_var","protected":false,"masked":true,"environment_scope":"*"}]`)
+	})
+
+	variables, _, err := client.ProjectVariables.ListVariables(1, nil)
+	if err != nil {
+		t.Errorf("ProjectVariables.ListVariables returned error: %v", err)
+	}
+
+	want := []*ProjectVariable{{
+		Key:              "TEST_VARIABLE_1",
+		Value:            "test1",
+		VariableType:     EnvVariableType,
+		Masked:           true,
+		EnvironmentScope: "*",
+	}}
+	if !reflect.DeepEqual(want, variables) {
+		t.Errorf("ProjectVariables.ListVariables returned %+v, want %+v", variables, want)
+	}
+}
+
+func TestGetProjectVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/TEST_VARIABLE_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/variables/TEST_VARIABLE_1?filter%5Benvironment_scope%5D=production")
+		fmt.Fprint(w, `{"key":"TEST_VARIABLE_1","value":"test1","raw":true,"environment_scope":"production"}`)
+	})
+
+	opt := &GetProjectVariableOptions{
+		Filter: &VariableFilter{EnvironmentScope: String("production")},
+	}
+
+	variable, _, err := client.ProjectVariables.GetVariable(1, "TEST_VARIABLE_1", opt)
+	if err != nil {
+		t.Errorf("ProjectVariables.GetVariable returned error: %v", err)
+	}
+
+	want := &ProjectVariable{Key: "TEST_VARIABLE_1", Value: "test1", Raw: true, EnvironmentScope: "production"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("ProjectVariables.GetVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestUpdateProjectVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/TEST_VARIABLE_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"value":"rotated","filter":{"environment_scope":"production"}}`)
+		fmt.Fprint(w, `{"key":"TEST_VARIABLE_1","value":"rotated","environment_scope":"production"}`)
+	})
+
+	opt := &UpdateProjectVariableOptions{
+		Value:  String("rotated"),
+		Filter: &VariableFilter{EnvironmentScope: String("production")},
+	}
+
+	variable, _, err := client.ProjectVariables.UpdateVariable(1, "TEST_VARIABLE_1", opt)
+	if err != nil {
+		t.Errorf("ProjectVariables.UpdateVariable returned error: %v", err)
+	}
+
+	want := &ProjectVariable{Key: "TEST_VARIABLE_1", Value: "rotated", EnvironmentScope: "production"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("ProjectVariables.UpdateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestRemoveProjectVariableWithFilter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/TEST_VARIABLE_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/variables/TEST_VARIABLE_1?filter%5Benvironment_scope%5D=staging")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &RemoveProjectVariableOptions{
+		Filter: &VariableFilter{EnvironmentScope: String("staging")},
+	}
+
+	_, err := client.ProjectVariables.RemoveVariable(1, "TEST_VARIABLE_1", opt)
+	if err != nil {
+		t.Errorf("ProjectVariables.RemoveVariable returned error: %v", err)
+	}
+}
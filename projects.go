@@ -275,7 +275,59 @@ func (s *ProjectsService) ListUserProjects(uid interface{}, opt *ListProjectsOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/projects", user)
+	u := fmt.Sprintf("users/%s/projects", pathEscape(user))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var p []*Project
+	resp, err := s.client.Do(req, &p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// ListUserContributedProjects gets a list of visible projects a
+// given user has contributed to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-projects-a-user-has-contributed-to
+func (s *ProjectsService) ListUserContributedProjects(uid interface{}, opt *ListProjectsOptions, options ...RequestOptionFunc) ([]*Project, *Response, error) {
+	user, err := parseID(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("users/%s/contributed_projects", pathEscape(user))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var p []*Project
+	resp, err := s.client.Do(req, &p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// ListUserStarredProjects gets a list of projects starred by
+// the given user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#list-projects-starred-by-a-user
+func (s *ProjectsService) ListUserStarredProjects(uid interface{}, opt *ListProjectsOptions, options ...RequestOptionFunc) ([]*Project, *Response, error) {
+	user, err := parseID(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("users/%s/starred_projects", pathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -869,22 +921,42 @@ type ProjectMember struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#list-project-hooks
 type ProjectHook struct {
-	ID                       int        `json:"id"`
-	URL                      string     `json:"url"`
-	ConfidentialNoteEvents   bool       `json:"confidential_note_events"`
-	ProjectID                int        `json:"project_id"`
-	PushEvents               bool       `json:"push_events"`
-	PushEventsBranchFilter   string     `json:"push_events_branch_filter"`
-	IssuesEvents             bool       `json:"issues_events"`
-	ConfidentialIssuesEvents bool       `json:"confidential_issues_events"`
-	MergeRequestsEvents      bool       `json:"merge_requests_events"`
-	TagPushEvents            bool       `json:"tag_push_events"`
-	NoteEvents               bool       `json:"note_events"`
-	JobEvents                bool       `json:"job_events"`
-	PipelineEvents           bool       `json:"pipeline_events"`
-	WikiPageEvents           bool       `json:"wiki_page_events"`
-	EnableSSLVerification    bool       `json:"enable_ssl_verification"`
-	CreatedAt                *time.Time `json:"created_at"`
+	ID                       int                       `json:"id"`
+	URL                      string                    `json:"url"`
+	ConfidentialNoteEvents   bool                      `json:"confidential_note_events"`
+	ProjectID                int                       `json:"project_id"`
+	PushEvents               bool                      `json:"push_events"`
+	PushEventsBranchFilter   string                    `json:"push_events_branch_filter"`
+	IssuesEvents             bool                      `json:"issues_events"`
+	ConfidentialIssuesEvents bool                      `json:"confidential_issues_events"`
+	MergeRequestsEvents      bool                      `json:"merge_requests_events"`
+	TagPushEvents            bool                      `json:"tag_push_events"`
+	NoteEvents               bool                      `json:"note_events"`
+	JobEvents                bool                      `json:"job_events"`
+	PipelineEvents           bool                      `json:"pipeline_events"`
+	WikiPageEvents           bool                      `json:"wiki_page_events"`
+	DeploymentEvents         bool                      `json:"deployment_events"`
+	ReleasesEvents           bool                      `json:"releases_events"`
+	EnableSSLVerification    bool                      `json:"enable_ssl_verification"`
+	CustomWebhookTemplate    string                    `json:"custom_webhook_template"`
+	URLVariables             []*ProjectHookURLVariable `json:"url_variables"`
+	AlertStatus              string                    `json:"alert_status"`
+	DisabledUntil            *time.Time                `json:"disabled_until"`
+	CreatedAt                *time.Time                `json:"created_at"`
+}
+
+// ProjectHookURLVariable represents a URL variable of a project hook. GitLab
+// only returns the key of URL variables, never their value.
+type ProjectHookURLVariable struct {
+	Key string `json:"key"`
+}
+
+// ProjectHookURLVariableOptions represents a URL variable that can be set on
+// a project hook. URL variables keep secrets out of the stored hook URL, which
+// refers to them as {key}.
+type ProjectHookURLVariableOptions struct {
+	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Value *string `url:"value,omitempty" json:"value,omitempty"`
 }
 
 // ListProjectHooksOptions represents the available ListProjectHooks() options.
@@ -947,20 +1019,24 @@ func (s *ProjectsService) GetProjectHook(pid interface{}, hook int, options ...R
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#add-project-hook
 type AddProjectHookOptions struct {
-	URL                      *string `url:"url,omitempty" json:"url,omitempty"`
-	ConfidentialNoteEvents   *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
-	PushEvents               *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
-	PushEventsBranchFilter   *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
-	IssuesEvents             *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
-	ConfidentialIssuesEvents *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
-	MergeRequestsEvents      *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	TagPushEvents            *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
-	NoteEvents               *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
-	JobEvents                *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
-	PipelineEvents           *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
-	WikiPageEvents           *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
-	EnableSSLVerification    *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
-	Token                    *string `url:"token,omitempty" json:"token,omitempty"`
+	URL                      *string                          `url:"url,omitempty" json:"url,omitempty"`
+	ConfidentialNoteEvents   *bool                            `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
+	PushEvents               *bool                            `url:"push_events,omitempty" json:"push_events,omitempty"`
+	PushEventsBranchFilter   *string                          `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
+	IssuesEvents             *bool                            `url:"issues_events,omitempty" json:"issues_events,omitempty"`
+	ConfidentialIssuesEvents *bool                            `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
+	MergeRequestsEvents      *bool                            `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	TagPushEvents            *bool                            `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	NoteEvents               *bool                            `url:"note_events,omitempty" json:"note_events,omitempty"`
+	JobEvents                *bool                            `url:"job_events,omitempty" json:"job_events,omitempty"`
+	PipelineEvents           *bool                            `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
+	WikiPageEvents           *bool                            `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
+	DeploymentEvents         *bool                            `url:"deployment_events,omitempty" json:"deployment_events,omitempty"`
+	ReleasesEvents           *bool                            `url:"releases_events,omitempty" json:"releases_events,omitempty"`
+	EnableSSLVerification    *bool                            `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	Token                    *string                          `url:"token,omitempty" json:"token,omitempty"`
+	CustomWebhookTemplate    *string                          `url:"custom_webhook_template,omitempty" json:"custom_webhook_template,omitempty"`
+	URLVariables             []*ProjectHookURLVariableOptions `url:"url_variables,omitempty" json:"url_variables,omitempty"`
 }
 
 // AddProjectHook adds a hook to a specified project.
@@ -993,20 +1069,24 @@ func (s *ProjectsService) AddProjectHook(pid interface{}, opt *AddProjectHookOpt
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#edit-project-hook
 type EditProjectHookOptions struct {
-	URL                      *string `url:"url,omitempty" json:"url,omitempty"`
-	ConfidentialNoteEvents   *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
-	PushEvents               *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
-	PushEventsBranchFilter   *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
-	IssuesEvents             *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
-	ConfidentialIssuesEvents *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
-	MergeRequestsEvents      *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	TagPushEvents            *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
-	NoteEvents               *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
-	JobEvents                *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
-	PipelineEvents           *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
-	WikiPageEvents           *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
-	EnableSSLVerification    *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
-	Token                    *string `url:"token,omitempty" json:"token,omitempty"`
+	URL                      *string                          `url:"url,omitempty" json:"url,omitempty"`
+	ConfidentialNoteEvents   *bool                            `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
+	PushEvents               *bool                            `url:"push_events,omitempty" json:"push_events,omitempty"`
+	PushEventsBranchFilter   *string                          `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
+	IssuesEvents             *bool                            `url:"issues_events,omitempty" json:"issues_events,omitempty"`
+	ConfidentialIssuesEvents *bool                            `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
+	MergeRequestsEvents      *bool                            `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	TagPushEvents            *bool                            `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	NoteEvents               *bool                            `url:"note_events,omitempty" json:"note_events,omitempty"`
+	JobEvents                *bool                            `url:"job_events,omitempty" json:"job_events,omitempty"`
+	PipelineEvents           *bool                            `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
+	WikiPageEvents           *bool                            `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
+	DeploymentEvents         *bool                            `url:"deployment_events,omitempty" json:"deployment_events,omitempty"`
+	ReleasesEvents           *bool                            `url:"releases_events,omitempty" json:"releases_events,omitempty"`
+	EnableSSLVerification    *bool                            `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	Token                    *string                          `url:"token,omitempty" json:"token,omitempty"`
+	CustomWebhookTemplate    *string                          `url:"custom_webhook_template,omitempty" json:"custom_webhook_template,omitempty"`
+	URLVariables             []*ProjectHookURLVariableOptions `url:"url_variables,omitempty" json:"url_variables,omitempty"`
 }
 
 // EditProjectHook edits a hook for a specified project.
@@ -1054,6 +1134,119 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 	return s.client.Do(req, nil)
 }
 
+// ProjectHookEvent represents an event that can be used to trigger a test of
+// a project hook.
+type ProjectHookEvent string
+
+// List of available project hook events.
+const (
+	ProjectHookEventPush                ProjectHookEvent = "push_events"
+	ProjectHookEventTagPush             ProjectHookEvent = "tag_push_events"
+	ProjectHookEventIssues              ProjectHookEvent = "issues_events"
+	ProjectHookEventConfidentialIssues  ProjectHookEvent = "confidential_issues_events"
+	ProjectHookEventNote                ProjectHookEvent = "note_events"
+	ProjectHookEventMergeRequests       ProjectHookEvent = "merge_requests_events"
+	ProjectHookEventJob                 ProjectHookEvent = "job_events"
+	ProjectHookEventPipeline            ProjectHookEvent = "pipeline_events"
+	ProjectHookEventWikiPage            ProjectHookEvent = "wiki_page_events"
+	ProjectHookEventReleases            ProjectHookEvent = "releases_events"
+	ProjectHookEventEmoji               ProjectHookEvent = "emoji_events"
+	ProjectHookEventResourceAccessToken ProjectHookEvent = "resource_access_token_events"
+)
+
+// TriggerTestProjectHook triggers a test of a project hook by sending it a
+// sample payload for the given event. A successful test re-enables a hook
+// that GitLab disabled after repeated failures, as reported by the hook's
+// AlertStatus and DisabledUntil fields.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
+func (s *ProjectsService) TriggerTestProjectHook(pid interface{}, hook int, event ProjectHookEvent, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/test/%s", pathEscape(project), hook, event)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ProjectHookLog represents a single delivery of a project hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+type ProjectHookLog struct {
+	ID                int                    `json:"id"`
+	URL               string                 `json:"url"`
+	Trigger           string                 `json:"trigger"`
+	RequestHeaders    map[string]string      `json:"request_headers"`
+	RequestData       map[string]interface{} `json:"request_data"`
+	ResponseHeaders   map[string]string      `json:"response_headers"`
+	ResponseBody      string                 `json:"response_body"`
+	ExecutionDuration float64                `json:"execution_duration"`
+	ResponseStatus    string                 `json:"response_status"`
+}
+
+// ListProjectHookEventsOptions represents the available
+// ListProjectHookEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+type ListProjectHookEventsOptions struct {
+	ListOptions
+	Status []int `url:"status[],omitempty" json:"status,omitempty"`
+}
+
+// ListProjectHookEvents gets the recent deliveries of a project hook. This
+// endpoint is only available in recent GitLab versions.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#list-project-hook-events
+func (s *ProjectsService) ListProjectHookEvents(pid interface{}, hook int, opt *ListProjectHookEventsOptions, options ...RequestOptionFunc) ([]*ProjectHookLog, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events", pathEscape(project), hook)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var logs []*ProjectHookLog
+	resp, err := s.client.Do(req, &logs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return logs, resp, err
+}
+
+// ResendProjectHookEvent resends a previous delivery of a project hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#resend-a-project-hook-event
+func (s *ProjectsService) ResendProjectHookEvent(pid interface{}, hook int, event int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events/%d/resend", pathEscape(project), hook, event)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ProjectForkRelation represents a project fork relationship.
 //
 // GitLab API docs:
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestListProjects(t *testing.T) {
@@ -70,6 +71,77 @@ func TestListUserProjects(t *testing.T) {
 	}
 }
 
+func TestListUserProjectsByUsername(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/john_smith/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/john_smith/projects?owned=true")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	projects, _, err := client.Projects.ListUserProjects("john_smith", &ListProjectsOptions{Owned: Bool(true)})
+	if err != nil {
+		t.Errorf("Projects.ListUserProjects returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListUserProjects returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestListUserContributedProjects(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1/contributed_projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/1/contributed_projects?page=2&per_page=3&simple=true")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	opt := &ListProjectsOptions{
+		ListOptions: ListOptions{2, 3},
+		Simple:      Bool(true),
+	}
+	projects, _, err := client.Projects.ListUserContributedProjects(1, opt)
+	if err != nil {
+		t.Errorf("Projects.ListUserContributedProjects returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListUserContributedProjects returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestListUserStarredProjects(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1/starred_projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/1/starred_projects?order_by=name&visibility=public")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	opt := &ListProjectsOptions{
+		OrderBy:    String("name"),
+		Visibility: Visibility(PublicVisibility),
+	}
+	projects, _, err := client.Projects.ListUserStarredProjects(1, opt)
+	if err != nil {
+		t.Errorf("Projects.ListUserStarredProjects returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListUserStarredProjects returned %+v, want %+v", projects, want)
+	}
+}
+
 func TestListProjectsUsersByID(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -905,3 +977,128 @@ func TestCreateProjectApprovalRule(t *testing.T) {
 		t.Errorf("Projects.CreateProjectApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestTriggerTestProjectHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/test/merge_requests_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"message":"201 Created"}`)
+	})
+
+	resp, err := client.Projects.TriggerTestProjectHook(1, 2, ProjectHookEventMergeRequests)
+	if err != nil {
+		t.Fatalf("Projects.TriggerTestProjectHook returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Projects.TriggerTestProjectHook returned status %v, want %v", resp.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestListProjectHookEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/hooks/2/events?status%5B%5D=500")
+		fmt.Fprint(w, `[{"id":9,"url":"https://example.com/hook","trigger":"push_hooks","request_headers":{"X-Gitlab-Event":"Push Hook"},"request_data":{"object_kind":"push"},"response_headers":{},"response_body":"oops","execution_duration":1.5,"response_status":"500"}]`)
+	})
+
+	opt := &ListProjectHookEventsOptions{Status: []int{500}}
+	logs, _, err := client.Projects.ListProjectHookEvents(1, 2, opt)
+	if err != nil {
+		t.Fatalf("Projects.ListProjectHookEvents returned error: %v", err)
+	}
+
+	want := []*ProjectHookLog{{
+		ID:                9,
+		URL:               "https://example.com/hook",
+		Trigger:           "push_hooks",
+		RequestHeaders:    map[string]string{"X-Gitlab-Event": "Push Hook"},
+		RequestData:       map[string]interface{}{"object_kind": "push"},
+		ResponseHeaders:   map[string]string{},
+		ResponseBody:      "oops",
+		ExecutionDuration: 1.5,
+		ResponseStatus:    "500",
+	}}
+	if !reflect.DeepEqual(want, logs) {
+		t.Errorf("Projects.ListProjectHookEvents returned %+v, want %+v", logs, want)
+	}
+}
+
+func TestResendProjectHookEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/events/9/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Projects.ResendProjectHookEvent(1, 2, 9)
+	if err != nil {
+		t.Fatalf("Projects.ResendProjectHookEvent returned error: %v", err)
+	}
+}
+
+func TestAddProjectHookWithURLVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"url":"https://example.com/hook/{token}","push_events_branch_filter":"main","releases_events":true,"custom_webhook_template":"{\"event\":\"{{object_kind}}\"}","url_variables":[{"key":"token","value":"secret"}]}`)
+		fmt.Fprint(w, `{"id":1,"url":"https://example.com/hook/{token}","push_events_branch_filter":"main","releases_events":true,"url_variables":[{"key":"token"}],"alert_status":"executable"}`)
+	})
+
+	opt := &AddProjectHookOptions{
+		URL:                    String("https://example.com/hook/{token}"),
+		PushEventsBranchFilter: String("main"),
+		ReleasesEvents:         Bool(true),
+		CustomWebhookTemplate:  String(`{"event":"{{object_kind}}"}`),
+		URLVariables: []*ProjectHookURLVariableOptions{
+			{Key: String("token"), Value: String("secret")},
+		},
+	}
+	hook, _, err := client.Projects.AddProjectHook(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.AddProjectHook returned error: %v", err)
+	}
+
+	want := &ProjectHook{
+		ID:                     1,
+		URL:                    "https://example.com/hook/{token}",
+		PushEventsBranchFilter: "main",
+		ReleasesEvents:         true,
+		URLVariables:           []*ProjectHookURLVariable{{Key: "token"}},
+		AlertStatus:            "executable",
+	}
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("Projects.AddProjectHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestGetDisabledProjectHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"alert_status":"temporarily_disabled","disabled_until":"2023-03-14T10:00:00Z"}`)
+	})
+
+	hook, _, err := client.Projects.GetProjectHook(1, 1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectHook returned error: %v", err)
+	}
+
+	disabledUntil := time.Date(2023, 3, 14, 10, 0, 0, 0, time.UTC)
+	want := &ProjectHook{ID: 1, AlertStatus: "temporarily_disabled", DisabledUntil: &disabledUntil}
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("Projects.GetProjectHook returned %+v, want %+v", hook, want)
+	}
+}
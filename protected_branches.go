@@ -36,6 +36,7 @@ type ProtectedBranchesService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/protected_branches.html#protected-branches-api
 type BranchAccessDescription struct {
+	ID                     int              `json:"id"`
 	AccessLevel            AccessLevelValue `json:"access_level"`
 	UserID                 int              `json:"user_id"`
 	GroupID                int              `json:"group_id"`
@@ -52,6 +53,7 @@ type ProtectedBranch struct {
 	PushAccessLevels          []*BranchAccessDescription `json:"push_access_levels"`
 	MergeAccessLevels         []*BranchAccessDescription `json:"merge_access_levels"`
 	UnprotectAccessLevels     []*BranchAccessDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                       `json:"allow_force_push"`
 	CodeOwnerApprovalRequired bool                       `json:"code_owner_approval_required"`
 }
 
@@ -125,6 +127,7 @@ type ProtectRepositoryBranchesOptions struct {
 	AllowedToPush             []*ProtectBranchPermissionOptions `url:"allowed_to_push,omitempty" json:"allowed_to_push,omitempty"`
 	AllowedToMerge            []*ProtectBranchPermissionOptions `url:"allowed_to_merge,omitempty" json:"allowed_to_merge,omitempty"`
 	AllowedToUnprotect        []*ProtectBranchPermissionOptions `url:"allowed_to_unprotect,omitempty" json:"allowed_to_unprotect,omitempty"`
+	AllowForcePush            *bool                             `url:"allow_force_push,omitempty" json:"allow_force_push,omitempty"`
 	CodeOwnerApprovalRequired *bool                             `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
 }
 
@@ -139,7 +142,8 @@ type ProtectBranchPermissionOptions struct {
 }
 
 // ProtectRepositoryBranches protects a single repository branch or several
-// project repository branches using a wildcard protected branch.
+// project repository branches using a wildcard protected branch, e.g.
+// "release-*".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/protected_branches.html#protect-repository-branches
@@ -211,3 +215,39 @@ func (s *ProtectedBranchesService) RequireCodeOwnerApprovals(pid interface{}, br
 
 	return s.client.Do(req, nil)
 }
+
+// UpdateProtectedBranchOptions represents the available
+// UpdateProtectedBranch() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
+type UpdateProtectedBranchOptions struct {
+	Name                      *string `url:"name,omitempty" json:"name,omitempty"`
+	AllowForcePush            *bool   `url:"allow_force_push,omitempty" json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired *bool   `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
+}
+
+// UpdateProtectedBranch updates a protected branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
+func (s *ProtectedBranchesService) UpdateProtectedBranch(pid interface{}, branch string, opt *UpdateProtectedBranchOptions, options ...RequestOptionFunc) (*ProtectedBranch, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", pathEscape(project), url.PathEscape(branch))
+
+	req, err := s.client.NewRequest("PATCH", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(ProtectedBranch)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
@@ -180,3 +180,113 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 		t.Errorf("ProtectedBranches.UpdateRepositoryBranchesOptions returned error: %v", err)
 	}
 }
+
+func TestProtectRepositoryBranchesWithAllowedToPush(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"release-*","merge_access_level":40,"allowed_to_push":[{"user_id":5},{"group_id":7},{"access_level":40}],"allow_force_push":true}`)
+		fmt.Fprint(w, `
+	{
+		"id":2,
+		"name":"release-*",
+		"push_access_levels":[
+			{"id":1,"access_level":40,"access_level_description":"Maintainers"},
+			{"id":2,"access_level":40,"user_id":5,"access_level_description":"John Smith"},
+			{"id":3,"access_level":40,"group_id":7,"access_level_description":"Release managers"}
+		],
+		"merge_access_levels":[{"id":4,"access_level":40,"access_level_description":"Maintainers"}],
+		"allow_force_push":true
+	}`)
+	})
+
+	opt := &ProtectRepositoryBranchesOptions{
+		Name:             String("release-*"),
+		MergeAccessLevel: AccessLevel(MaintainerPermissions),
+		AllowedToPush: []*ProtectBranchPermissionOptions{
+			{UserID: Int(5)},
+			{GroupID: Int(7)},
+			{AccessLevel: AccessLevel(MaintainerPermissions)},
+		},
+		AllowForcePush: Bool(true),
+	}
+	branch, _, err := client.ProtectedBranches.ProtectRepositoryBranches(1, opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.ProtectRepositoryBranches returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{
+		ID:   2,
+		Name: "release-*",
+		PushAccessLevels: []*BranchAccessDescription{
+			{ID: 1, AccessLevel: MaintainerPermissions, AccessLevelDescription: "Maintainers"},
+			{ID: 2, AccessLevel: MaintainerPermissions, UserID: 5, AccessLevelDescription: "John Smith"},
+			{ID: 3, AccessLevel: MaintainerPermissions, GroupID: 7, AccessLevelDescription: "Release managers"},
+		},
+		MergeAccessLevels: []*BranchAccessDescription{
+			{ID: 4, AccessLevel: MaintainerPermissions, AccessLevelDescription: "Maintainers"},
+		},
+		AllowForcePush: true,
+	}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.ProtectRepositoryBranches returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestGetProtectedBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/release-*", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"release-*","allow_force_push":false,"code_owner_approval_required":true}`)
+	})
+
+	branch, _, err := client.ProtectedBranches.GetProtectedBranch(1, "release-*")
+	if err != nil {
+		t.Fatalf("ProtectedBranches.GetProtectedBranch returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{ID: 2, Name: "release-*", CodeOwnerApprovalRequired: true}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.GetProtectedBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestUpdateProtectedBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/master", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, "/api/v4/projects/1/protected_branches/master?allow_force_push=true")
+		fmt.Fprint(w, `{"id":1,"name":"master","allow_force_push":true}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{AllowForcePush: Bool(true)}
+	branch, _, err := client.ProtectedBranches.UpdateProtectedBranch(1, "master", opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.UpdateProtectedBranch returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{ID: 1, Name: "master", AllowForcePush: true}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestUnprotectRepositoryBranches(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/release-*", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.ProtectedBranches.UnprotectRepositoryBranches(1, "release-*")
+	if err != nil {
+		t.Fatalf("ProtectedBranches.UnprotectRepositoryBranches returned error: %v", err)
+	}
+}
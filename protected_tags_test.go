@@ -97,6 +97,39 @@ func TestProtectRepositoryTags(t *testing.T) {
 	assert.Equal(t, expected, tag)
 }
 
+func TestProtectRepositoryTagsWithAllowedToCreate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"v*","create_access_level":0,"allowed_to_create":[{"user_id":42}]}`)
+		fmt.Fprint(w, `{"name":"v*", "create_access_levels": [{"id": 1, "user_id": 42, "access_level": 40, "access_level_description": "release-bot"}]}`)
+	})
+
+	expected := &ProtectedTag{
+		Name: "v*",
+		CreateAccessLevels: []*TagAccessDescription{
+			{
+				ID:                     1,
+				UserID:                 42,
+				AccessLevel:            MaintainerPermissions,
+				AccessLevelDescription: "release-bot",
+			},
+		},
+	}
+
+	opt := &ProtectRepositoryTagsOptions{
+		Name:              String("v*"),
+		CreateAccessLevel: AccessLevel(NoPermissions),
+		AllowedToCreate:   []*ProtectTagPermissionOptions{{UserID: Int(42)}},
+	}
+	tag, _, err := client.ProtectedTags.ProtectRepositoryTags(1, opt)
+
+	assert.NoError(t, err, "failed to get response")
+	assert.Equal(t, expected, tag)
+}
+
 func TestUnprotectRepositoryTags(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -17,11 +18,15 @@ type ContainerRegistryService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/container_registry.html
 type RegistryRepository struct {
-	ID        int        `json:"id"`
-	Name      string     `json:"name"`
-	Path      string     `json:"path"`
-	Location  string     `json:"location"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID                     int                      `json:"id"`
+	Name                   string                   `json:"name"`
+	Path                   string                   `json:"path"`
+	ProjectID              int                      `json:"project_id"`
+	Location               string                   `json:"location"`
+	CreatedAt              *time.Time               `json:"created_at"`
+	CleanupPolicyStartedAt *time.Time               `json:"cleanup_policy_started_at"`
+	TagsCount              int                      `json:"tags_count"`
+	Tags                   []*RegistryRepositoryTag `json:"tags"`
 }
 
 func (s RegistryRepository) String() string {
@@ -51,7 +56,11 @@ func (s RegistryRepositoryTag) String() string {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#list-registry-repositories
-type ListRegistryRepositoriesOptions ListOptions
+type ListRegistryRepositoriesOptions struct {
+	ListOptions
+	Tags      *bool `url:"tags,omitempty" json:"tags,omitempty"`
+	TagsCount *bool `url:"tags_count,omitempty" json:"tags_count,omitempty"`
+}
 
 // ListRegistryRepositories gets a list of registry repositories in a project.
 //
@@ -78,6 +87,43 @@ func (s *ContainerRegistryService) ListRegistryRepositories(pid interface{}, opt
 	return repos, resp, err
 }
 
+// GetSingleRegistryRepositoryOptions represents the available
+// GetSingleRegistryRepository() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_registry.html#get-details-of-a-single-repository
+type GetSingleRegistryRepositoryOptions struct {
+	Tags      *bool `url:"tags,omitempty" json:"tags,omitempty"`
+	TagsCount *bool `url:"tags_count,omitempty" json:"tags_count,omitempty"`
+}
+
+// GetSingleRegistryRepository gets the details of a single registry
+// repository. The repository can be identified by its ID or by its URL
+// encoded path.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_registry.html#get-details-of-a-single-repository
+func (s *ContainerRegistryService) GetSingleRegistryRepository(pid interface{}, opt *GetSingleRegistryRepositoryOptions, options ...RequestOptionFunc) (*RegistryRepository, *Response, error) {
+	repository, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("registry/repositories/%s", pathEscape(repository))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repo := new(RegistryRepository)
+	resp, err := s.client.Do(req, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repo, resp, err
+}
+
 // DeleteRegistryRepository deletes a repository in a registry.
 //
 // GitLab API docs:
@@ -144,7 +190,7 @@ func (s *ContainerRegistryService) GetRegistryRepositoryTagDetail(pid interface{
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		pathEscape(project),
 		repository,
-		tagName,
+		url.PathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
@@ -153,7 +199,7 @@ func (s *ContainerRegistryService) GetRegistryRepositoryTagDetail(pid interface{
 	}
 
 	tag := new(RegistryRepositoryTag)
-	resp, err := s.client.Do(req, &tag)
+	resp, err := s.client.Do(req, tag)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -173,7 +219,7 @@ func (s *ContainerRegistryService) DeleteRegistryRepositoryTag(pid interface{},
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		pathEscape(project),
 		repository,
-		tagName,
+		url.PathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
@@ -200,7 +246,9 @@ type DeleteRegistryRepositoryTagsOptions struct {
 }
 
 // DeleteRegistryRepositoryTags deletes repository tags in bulk based on
-// given criteria.
+// given criteria. The deletion is executed asynchronously and GitLab only
+// allows it once per hour for a given repository, so repeated calls within
+// that window return a 400 error.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-repository-tags-in-bulk
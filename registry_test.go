@@ -0,0 +1,158 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListRegistryRepositories(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/registry/repositories?tags=true&tags_count=true")
+		fmt.Fprint(w, `[{"id":1,"name":"","path":"group/project","project_id":9,"location":"gitlab.example.com:5000/group/project","created_at":"2019-01-10T13:38:57.391Z","tags_count":1,"tags":[{"name":"0.0.1","path":"group/project:0.0.1","location":"gitlab.example.com:5000/group/project:0.0.1"}]}]`)
+	})
+
+	opt := &ListRegistryRepositoriesOptions{
+		Tags:      Bool(true),
+		TagsCount: Bool(true),
+	}
+
+	repos, _, err := client.ContainerRegistry.ListRegistryRepositories(5, opt)
+	if err != nil {
+		t.Fatalf("ContainerRegistry.ListRegistryRepositories returned error: %v", err)
+	}
+
+	createdAt := time.Date(2019, 1, 10, 13, 38, 57, 391000000, time.UTC)
+	want := []*RegistryRepository{{
+		ID:        1,
+		Path:      "group/project",
+		ProjectID: 9,
+		Location:  "gitlab.example.com:5000/group/project",
+		CreatedAt: &createdAt,
+		TagsCount: 1,
+		Tags: []*RegistryRepositoryTag{{
+			Name:     "0.0.1",
+			Path:     "group/project:0.0.1",
+			Location: "gitlab.example.com:5000/group/project:0.0.1",
+		}},
+	}}
+	if !reflect.DeepEqual(want, repos) {
+		t.Errorf("ContainerRegistry.ListRegistryRepositories returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestGetSingleRegistryRepository(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/registry/repositories/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/registry/repositories/2?tags_count=true")
+		fmt.Fprint(w, `{"id":2,"name":"releases","path":"group/project/releases","project_id":9,"tags_count":12}`)
+	})
+
+	repo, _, err := client.ContainerRegistry.GetSingleRegistryRepository(2, &GetSingleRegistryRepositoryOptions{TagsCount: Bool(true)})
+	if err != nil {
+		t.Fatalf("ContainerRegistry.GetSingleRegistryRepository returned error: %v", err)
+	}
+
+	want := &RegistryRepository{ID: 2, Name: "releases", Path: "group/project/releases", ProjectID: 9, TagsCount: 12}
+	if !reflect.DeepEqual(want, repo) {
+		t.Errorf("ContainerRegistry.GetSingleRegistryRepository returned %+v, want %+v", repo, want)
+	}
+}
+
+func TestGetRegistryRepositoryTagDetail(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags/v10.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"v10.0.0","path":"group/project:latest","location":"gitlab.example.com:5000/group/project:latest","revision":"e9ed9d87c881d8c2fd3a31b41904d01ba0b836e7fd15240d774d811a1c248181","short_revision":"e9ed9d87c","digest":"sha256:c3490dcf10ffb6530c1303522a1405dfaf7daecd8f38d3d2b3a0c10ca058cba4","created_at":"2019-01-06T16:49:51.272Z","total_size":350224384}`)
+	})
+
+	tag, _, err := client.ContainerRegistry.GetRegistryRepositoryTagDetail(5, 2, "v10.0.0")
+	if err != nil {
+		t.Fatalf("ContainerRegistry.GetRegistryRepositoryTagDetail returned error: %v", err)
+	}
+
+	createdAt := time.Date(2019, 1, 6, 16, 49, 51, 272000000, time.UTC)
+	want := &RegistryRepositoryTag{
+		Name:          "v10.0.0",
+		Path:          "group/project:latest",
+		Location:      "gitlab.example.com:5000/group/project:latest",
+		Revision:      "e9ed9d87c881d8c2fd3a31b41904d01ba0b836e7fd15240d774d811a1c248181",
+		ShortRevision: "e9ed9d87c",
+		Digest:        "sha256:c3490dcf10ffb6530c1303522a1405dfaf7daecd8f38d3d2b3a0c10ca058cba4",
+		CreatedAt:     &createdAt,
+		TotalSize:     350224384,
+	}
+	if !reflect.DeepEqual(want, tag) {
+		t.Errorf("ContainerRegistry.GetRegistryRepositoryTagDetail returned %+v, want %+v", tag, want)
+	}
+}
+
+func TestDeleteRegistryRepositoryTags(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/5/registry/repositories/2/tags?keep_n=5&name_regex_delete=feature-.%2A&name_regex_keep=stable&older_than=1month")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &DeleteRegistryRepositoryTagsOptions{
+		NameRegexpDelete: String("feature-.*"),
+		NameRegexpKeep:   String("stable"),
+		KeepN:            Int(5),
+		OlderThan:        String("1month"),
+	}
+
+	resp, err := client.ContainerRegistry.DeleteRegistryRepositoryTags(5, 2, opt)
+	if err != nil {
+		t.Fatalf("ContainerRegistry.DeleteRegistryRepositoryTags returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("ContainerRegistry.DeleteRegistryRepositoryTags returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestDeleteRegistryRepositoryTagsRateLimited(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"This request has already been made. You can run this at most once an hour for a given container repository"}`)
+	})
+
+	opt := &DeleteRegistryRepositoryTagsOptions{NameRegexpDelete: String(".*")}
+
+	resp, err := client.ContainerRegistry.DeleteRegistryRepositoryTags(5, 2, opt)
+	if err == nil {
+		t.Fatal("ContainerRegistry.DeleteRegistryRepositoryTags expected an error")
+	}
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("ContainerRegistry.DeleteRegistryRepositoryTags returned status %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("ContainerRegistry.DeleteRegistryRepositoryTags returned error type %T, want *ErrorResponse", err)
+	}
+
+	want := "{message: This request has already been made. You can run this at most once an hour for a given container repository}"
+	if errResp.Message != want {
+		t.Errorf("ContainerRegistry.DeleteRegistryRepositoryTags returned message %q, want %q", errResp.Message, want)
+	}
+}
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // ReleaseLinksService handles communication with the release link methods
@@ -16,10 +17,12 @@ type ReleaseLinksService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/releases/links.html
 type ReleaseLink struct {
-	ID       int    `json:"id"`
-	Name     string `json:"name"`
-	URL      string `json:"url"`
-	External bool   `json:"external"`
+	ID             int           `json:"id"`
+	Name           string        `json:"name"`
+	URL            string        `json:"url"`
+	DirectAssetURL string        `json:"direct_asset_url"`
+	External       bool          `json:"external"`
+	LinkType       LinkTypeValue `json:"link_type"`
 }
 
 // ListReleaseLinksOptions represents ListReleaseLinks() options.
@@ -35,7 +38,7 @@ func (s *ReleaseLinksService) ListReleaseLinks(pid interface{}, tagName string,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/releases/%s/assets/links", pathEscape(project), tagName)
+	u := fmt.Sprintf("projects/%s/releases/%s/assets/links", pathEscape(project), url.PathEscape(tagName))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -61,7 +64,7 @@ func (s *ReleaseLinksService) GetReleaseLink(pid interface{}, tagName string, li
 	}
 	u := fmt.Sprintf("projects/%s/releases/%s/assets/links/%d",
 		pathEscape(project),
-		tagName,
+		url.PathEscape(tagName),
 		link)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
@@ -82,8 +85,10 @@ func (s *ReleaseLinksService) GetReleaseLink(pid interface{}, tagName string, li
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/releases/links.html#create-a-link
 type CreateReleaseLinkOptions struct {
-	Name *string `url:"name" json:"name"`
-	URL  *string `url:"url" json:"url"`
+	Name     *string        `url:"name" json:"name"`
+	URL      *string        `url:"url" json:"url"`
+	FilePath *string        `url:"filepath,omitempty" json:"filepath,omitempty"`
+	LinkType *LinkTypeValue `url:"link_type,omitempty" json:"link_type,omitempty"`
 }
 
 // CreateReleaseLink creates a link.
@@ -94,7 +99,7 @@ func (s *ReleaseLinksService) CreateReleaseLink(pid interface{}, tagName string,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/releases/%s/assets/links", pathEscape(project), tagName)
+	u := fmt.Sprintf("projects/%s/releases/%s/assets/links", pathEscape(project), url.PathEscape(tagName))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -116,8 +121,10 @@ func (s *ReleaseLinksService) CreateReleaseLink(pid interface{}, tagName string,
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/releases/links.html#update-a-link
 type UpdateReleaseLinkOptions struct {
-	Name *string `url:"name,omitempty" json:"name,omitempty"`
-	URL  *string `url:"url,omitempty" json:"url,omitempty"`
+	Name     *string        `url:"name,omitempty" json:"name,omitempty"`
+	URL      *string        `url:"url,omitempty" json:"url,omitempty"`
+	FilePath *string        `url:"filepath,omitempty" json:"filepath,omitempty"`
+	LinkType *LinkTypeValue `url:"link_type,omitempty" json:"link_type,omitempty"`
 }
 
 // UpdateReleaseLink updates an asset link.
@@ -130,7 +137,7 @@ func (s *ReleaseLinksService) UpdateReleaseLink(pid interface{}, tagName string,
 	}
 	u := fmt.Sprintf("projects/%s/releases/%s/assets/links/%d",
 		pathEscape(project),
-		tagName,
+		url.PathEscape(tagName),
 		link)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
@@ -157,7 +164,7 @@ func (s *ReleaseLinksService) DeleteReleaseLink(pid interface{}, tagName string,
 	}
 	u := fmt.Sprintf("projects/%s/releases/%s/assets/links/%d",
 		pathEscape(project),
-		tagName,
+		url.PathEscape(tagName),
 		link,
 	)
 
@@ -78,6 +78,34 @@ func TestReleaseLinksService_CreateReleaseLink(t *testing.T) {
 	}
 }
 
+func TestReleaseLinksService_CreateReleaseLinkWithLinkType(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v1.0.0/assets/links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"name":"app.tar.gz","url":"https://s3.example.com/app.tar.gz","filepath":"/binaries/app.tar.gz","link_type":"package"}`)
+			fmt.Fprint(w, `{"id":4,"name":"app.tar.gz","url":"https://s3.example.com/app.tar.gz","external":true,"link_type":"package"}`)
+		})
+
+	releaseLink, _, err := client.ReleaseLinks.CreateReleaseLink(
+		1, "v1.0.0",
+		&CreateReleaseLinkOptions{
+			Name:     String("app.tar.gz"),
+			URL:      String("https://s3.example.com/app.tar.gz"),
+			FilePath: String("/binaries/app.tar.gz"),
+			LinkType: LinkType(PackageLinkType),
+		})
+	if err != nil {
+		t.Error(err)
+	}
+	if releaseLink.LinkType != PackageLinkType {
+		t.Errorf("release link type, expected '%s', got '%s'", PackageLinkType,
+			releaseLink.LinkType)
+	}
+}
+
 func TestReleaseLinksService_GetReleaseLink(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -23,6 +24,8 @@ type Release struct {
 	Description     string     `json:"description,omitempty"`
 	DescriptionHTML string     `json:"description_html,omitempty"`
 	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	ReleasedAt      *time.Time `json:"released_at,omitempty"`
+	UpcomingRelease bool       `json:"upcoming_release"`
 	Author          struct {
 		ID        int    `json:"id"`
 		Name      string `json:"name"`
@@ -31,8 +34,10 @@ type Release struct {
 		AvatarURL string `json:"avatar_url"`
 		WebURL    string `json:"web_url"`
 	} `json:"author"`
-	Commit Commit `json:"commit"`
-	Assets struct {
+	Commit     Commit             `json:"commit"`
+	Milestones []*Milestone       `json:"milestones"`
+	Evidences  []*ReleaseEvidence `json:"evidences"`
+	Assets     struct {
 		Count   int `json:"count"`
 		Sources []struct {
 			Format string `json:"format"`
@@ -42,6 +47,16 @@ type Release struct {
 	} `json:"assets"`
 }
 
+// ReleaseEvidence represents the evidence collected for a project release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/index.html#collect-release-evidence
+type ReleaseEvidence struct {
+	SHA         string     `json:"sha"`
+	Filepath    string     `json:"filepath"`
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
 // ListReleasesOptions represents ListReleases() options.
 //
 // GitLab API docs:
@@ -82,7 +97,7 @@ func (s *ReleasesService) GetRelease(pid interface{}, tagName string, options ..
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), tagName)
+	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), url.PathEscape(tagName))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -111,8 +126,10 @@ type ReleaseAssets struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/releases/index.html#create-a-release
 type ReleaseAssetLink struct {
-	Name string `url:"name" json:"name"`
-	URL  string `url:"url" json:"url"`
+	Name     string         `url:"name" json:"name"`
+	URL      string         `url:"url" json:"url"`
+	FilePath *string        `url:"filepath,omitempty" json:"filepath,omitempty"`
+	LinkType *LinkTypeValue `url:"link_type,omitempty" json:"link_type,omitempty"`
 }
 
 // CreateReleaseOptions represents CreateRelease() options.
@@ -124,7 +141,9 @@ type CreateReleaseOptions struct {
 	TagName     *string        `url:"tag_name" json:"tag_name"`
 	Description *string        `url:"description" json:"description"`
 	Ref         *string        `url:"ref,omitempty" json:"ref,omitempty"`
+	Milestones  []string       `url:"milestones,omitempty" json:"milestones,omitempty"`
 	Assets      *ReleaseAssets `url:"assets,omitempty" json:"assets,omitempty"`
+	ReleasedAt  *time.Time     `url:"released_at,omitempty" json:"released_at,omitempty"`
 }
 
 // CreateRelease creates a release.
@@ -157,8 +176,10 @@ func (s *ReleasesService) CreateRelease(pid interface{}, opts *CreateReleaseOpti
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/releases/index.html#update-a-release
 type UpdateReleaseOptions struct {
-	Name        *string `url:"name" json:"name"`
-	Description *string `url:"description" json:"description"`
+	Name        *string    `url:"name,omitempty" json:"name,omitempty"`
+	Description *string    `url:"description,omitempty" json:"description,omitempty"`
+	Milestones  []string   `url:"milestones,omitempty" json:"milestones,omitempty"`
+	ReleasedAt  *time.Time `url:"released_at,omitempty" json:"released_at,omitempty"`
 }
 
 // UpdateRelease updates a release.
@@ -170,7 +191,7 @@ func (s *ReleasesService) UpdateRelease(pid interface{}, tagName string, opts *U
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), tagName)
+	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), url.PathEscape(tagName))
 
 	req, err := s.client.NewRequest("PUT", u, opts, options)
 	if err != nil {
@@ -178,7 +199,7 @@ func (s *ReleasesService) UpdateRelease(pid interface{}, tagName string, opts *U
 	}
 
 	r := new(Release)
-	resp, err := s.client.Do(req, &r)
+	resp, err := s.client.Do(req, r)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -195,7 +216,7 @@ func (s *ReleasesService) DeleteRelease(pid interface{}, tagName string, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), tagName)
+	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), url.PathEscape(tagName))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 const exampleReleaseListRsp = `[
@@ -260,7 +262,7 @@ func TestReleasesService_CreateReleaseWithAsset(t *testing.T) {
 		Description: String("Description"),
 		Assets: &ReleaseAssets{
 			Links: []*ReleaseAssetLink{
-				{"sldkf", "sldkfj"},
+				{Name: "sldkf", URL: "sldkfj"},
 			},
 		},
 	}
@@ -274,6 +276,70 @@ func TestReleasesService_CreateReleaseWithAsset(t *testing.T) {
 	}
 }
 
+func TestReleasesService_CreateReleaseWithMilestonesAndLinkTypes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"name":"v1.0.0","tag_name":"v1.0.0","description":"First release","ref":"master","milestones":["v1.0"],"assets":{"links":[{"name":"app-linux-amd64","url":"https://s3.example.com/app-linux-amd64","filepath":"/binaries/app-linux-amd64","link_type":"package"}]},"released_at":"2020-06-01T12:00:00Z"}`)
+			fmt.Fprint(w, `{"tag_name":"v1.0.0","name":"v1.0.0","released_at":"2020-06-01T12:00:00Z","milestones":[{"id":51,"iid":1,"title":"v1.0"}],"evidences":[{"sha":"760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d","filepath":"https://gitlab.example.com/root/app/-/releases/v1.0.0/evidence.json","collected_at":"2020-06-01T12:00:01Z"}],"assets":{"count":1,"links":[{"id":3,"name":"app-linux-amd64","url":"https://s3.example.com/app-linux-amd64","direct_asset_url":"https://gitlab.example.com/root/app/-/releases/v1.0.0/downloads/binaries/app-linux-amd64","external":true,"link_type":"package"}]}}`)
+		})
+
+	releasedAt := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	opts := &CreateReleaseOptions{
+		Name:        String("v1.0.0"),
+		TagName:     String("v1.0.0"),
+		Description: String("First release"),
+		Ref:         String("master"),
+		Milestones:  []string{"v1.0"},
+		ReleasedAt:  &releasedAt,
+		Assets: &ReleaseAssets{
+			Links: []*ReleaseAssetLink{
+				{
+					Name:     "app-linux-amd64",
+					URL:      "https://s3.example.com/app-linux-amd64",
+					FilePath: String("/binaries/app-linux-amd64"),
+					LinkType: LinkType(PackageLinkType),
+				},
+			},
+		},
+	}
+
+	release, _, err := client.Releases.CreateRelease(1, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if release.ReleasedAt == nil || !release.ReleasedAt.Equal(releasedAt) {
+		t.Errorf("expected released_at %s, got %v", releasedAt, release.ReleasedAt)
+	}
+	if len(release.Milestones) != 1 || release.Milestones[0].Title != "v1.0" {
+		t.Errorf("expected milestone v1.0, got %+v", release.Milestones)
+	}
+
+	wantEvidence := []*ReleaseEvidence{{
+		SHA:         "760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d",
+		Filepath:    "https://gitlab.example.com/root/app/-/releases/v1.0.0/evidence.json",
+		CollectedAt: Time(time.Date(2020, 6, 1, 12, 0, 1, 0, time.UTC)),
+	}}
+	if !reflect.DeepEqual(wantEvidence, release.Evidences) {
+		t.Errorf("expected evidences %+v, got %+v", wantEvidence, release.Evidences)
+	}
+
+	wantLinks := []*ReleaseLink{{
+		ID:             3,
+		Name:           "app-linux-amd64",
+		URL:            "https://s3.example.com/app-linux-amd64",
+		DirectAssetURL: "https://gitlab.example.com/root/app/-/releases/v1.0.0/downloads/binaries/app-linux-amd64",
+		External:       true,
+		LinkType:       PackageLinkType,
+	}}
+	if !reflect.DeepEqual(wantLinks, release.Assets.Links) {
+		t.Errorf("expected links %+v, got %+v", wantLinks, release.Assets.Links)
+	}
+}
+
 func TestReleasesService_UpdateRelease(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
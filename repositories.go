@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"time"
 )
 
 // RepositoriesService handles communication with the repositories related
@@ -55,9 +56,16 @@ type ListTreeOptions struct {
 	Path      *string `url:"path,omitempty" json:"path,omitempty"`
 	Ref       *string `url:"ref,omitempty" json:"ref,omitempty"`
 	Recursive *bool   `url:"recursive,omitempty" json:"recursive,omitempty"`
+
+	// Set Pagination to "keyset" to use keyset-based pagination. The
+	// PageToken of the next page is part of the Response.NextLink.
+	Pagination *string `url:"pagination,omitempty" json:"pagination,omitempty"`
+	PageToken  *string `url:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
 // ListTree gets a list of repository files and directories in a project.
+// Recursive listings of large repositories are best paginated using keyset
+// pagination.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#list-repository-tree
@@ -135,14 +143,43 @@ func (s *RepositoriesService) RawBlobContent(pid interface{}, sha string, option
 
 // ArchiveOptions represents the available Archive() options.
 //
+// Format must be one of "zip", "tar.gz", "tar.bz2" or "tar" and defaults to
+// "tar.gz". Path limits the archive to the given subdirectory.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
 type ArchiveOptions struct {
 	Format *string `url:"-" json:"-"`
+	Path   *string `url:"path,omitempty" json:"path,omitempty"`
 	SHA    *string `url:"sha,omitempty" json:"sha,omitempty"`
 }
 
-// Archive gets an archive of the repository.
+// archiveFormats lists the archive formats supported by GitLab.
+var archiveFormats = map[string]bool{
+	"zip":     true,
+	"tar.gz":  true,
+	"tar.bz2": true,
+	"tar":     true,
+}
+
+// archiveURL returns the URL of the repository archive, based on the
+// optional format.
+func archiveURL(project string, opt *ArchiveOptions) (string, error) {
+	u := fmt.Sprintf("projects/%s/repository/archive", pathEscape(project))
+
+	// Set an optional format for the archive.
+	if opt != nil && opt.Format != nil {
+		if !archiveFormats[*opt.Format] {
+			return "", fmt.Errorf("unsupported archive format: %q", *opt.Format)
+		}
+		u = fmt.Sprintf("%s.%s", u, *opt.Format)
+	}
+
+	return u, nil
+}
+
+// Archive gets an archive of the repository. The file name GitLab suggests
+// for the archive is available through Response.Filename().
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
@@ -151,11 +188,9 @@ func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/archive", pathEscape(project))
-
-	// Set an optional format for the archive.
-	if opt != nil && opt.Format != nil {
-		u = fmt.Sprintf("%s.%s", u, *opt.Format)
+	u, err := archiveURL(project, opt)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
@@ -173,7 +208,8 @@ func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, opti
 }
 
 // StreamArchive streams an archive of the repository to the provided
-// io.Writer.
+// io.Writer. The file name GitLab suggests for the archive is available
+// through Response.Filename().
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
@@ -182,11 +218,9 @@ func (s *RepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *A
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/archive", pathEscape(project))
-
-	// Set an optional format for the archive.
-	if opt != nil && opt.Format != nil {
-		u = fmt.Sprintf("%s.%s", u, *opt.Format)
+	u, err := archiveURL(project, opt)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
@@ -248,7 +282,8 @@ func (s *RepositoriesService) Compare(pid interface{}, opt *CompareOptions, opti
 	return c, resp, err
 }
 
-// Contributor represents a GitLap contributor.
+// Contributor represents a GitLab contributor. Note that GitLab often
+// reports 0 additions and deletions.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repositories.html#contributors
 type Contributor struct {
@@ -264,6 +299,7 @@ func (c Contributor) String() string {
 }
 
 // ListContributorsOptions represents the available ListContributors() options.
+// OrderBy can be "name", "email" or "commits" and Sort "asc" or "desc".
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repositories.html#contributors
 type ListContributorsOptions struct {
@@ -304,8 +340,9 @@ type MergeBaseOptions struct {
 	Ref []string `url:"refs[],omitempty" json:"refs,omitempty"`
 }
 
-// MergeBase gets the common ancestor for 2 refs (commit SHAs, branch
-// names or tags).
+// MergeBase gets the common ancestor for 2 or more refs (commit SHAs, branch
+// names or tags). GitLab returns a 400 Bad Request when fewer than 2 refs are
+// given.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#merge-base
@@ -329,3 +366,131 @@ func (s *RepositoriesService) MergeBase(pid interface{}, opt *MergeBaseOptions,
 
 	return c, resp, err
 }
+
+// UpdateSubmoduleOptions represents the available UpdateSubmodule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+type UpdateSubmoduleOptions struct {
+	Branch        *string `url:"branch,omitempty" json:"branch,omitempty"`
+	CommitSHA     *string `url:"commit_sha,omitempty" json:"commit_sha,omitempty"`
+	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+}
+
+// UpdateSubmodule updates an existing submodule reference to point to the
+// given commit SHA and returns the commit that made the change.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+func (s *RepositoriesService) UpdateSubmodule(pid interface{}, submodule string, opt *UpdateSubmoduleOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/repository/submodules/%s",
+		pathEscape(project),
+		url.PathEscape(submodule),
+	)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Commit)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
+
+// AddChangelogDataOptions represents the available AddChangelogData() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#add-changelog-data-to-a-changelog-file
+type AddChangelogDataOptions struct {
+	Version    *string    `url:"version,omitempty" json:"version,omitempty"`
+	Branch     *string    `url:"branch,omitempty" json:"branch,omitempty"`
+	ConfigFile *string    `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *time.Time `url:"date,omitempty" json:"date,omitempty"`
+	File       *string    `url:"file,omitempty" json:"file,omitempty"`
+	From       *string    `url:"from,omitempty" json:"from,omitempty"`
+	Message    *string    `url:"message,omitempty" json:"message,omitempty"`
+	To         *string    `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string    `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// AddChangelogData generates changelog data based on commits in a repository and
+// commits it to a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#add-changelog-data-to-a-changelog-file
+func (s *RepositoriesService) AddChangelogData(pid interface{}, opt *AddChangelogDataOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ChangelogData represents the generated changelog data.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+type ChangelogData struct {
+	Notes string `json:"notes"`
+}
+
+func (c ChangelogData) String() string {
+	return Stringify(c)
+}
+
+// GenerateChangelogDataOptions represents the available GenerateChangelogData()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+type GenerateChangelogDataOptions struct {
+	Version    *string    `url:"version,omitempty" json:"version,omitempty"`
+	ConfigFile *string    `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *time.Time `url:"date,omitempty" json:"date,omitempty"`
+	From       *string    `url:"from,omitempty" json:"from,omitempty"`
+	To         *string    `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string    `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// GenerateChangelogData generates changelog data based on commits in a
+// repository, without committing them to a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
+func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt *GenerateChangelogDataOptions, options ...RequestOptionFunc) (*ChangelogData, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cd := new(ChangelogData)
+	resp, err := s.client.Do(req, cd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cd, resp, err
+}
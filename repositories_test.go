@@ -0,0 +1,330 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListTree(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tree", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/tree?path=files%2Fhtml&per_page=2&recursive=true&ref=master")
+		fmt.Fprint(w, `[
+			{"id": "a1e8f8d745cc87e3a9248358d9352bb7f9a0aeba", "name": "html", "type": "tree", "path": "files/html", "mode": "040000"},
+			{"id": "4535904260b1082e14f867f7a24fd8c21495bde3", "name": "index.html", "type": "blob", "path": "files/html/index.html", "mode": "100644"}
+		]`)
+	})
+
+	opt := &ListTreeOptions{
+		ListOptions: ListOptions{PerPage: 2},
+		Path:        String("files/html"),
+		Ref:         String("master"),
+		Recursive:   Bool(true),
+	}
+	tree, _, err := client.Repositories.ListTree(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.ListTree returned error: %v", err)
+	}
+
+	want := []*TreeNode{
+		{ID: "a1e8f8d745cc87e3a9248358d9352bb7f9a0aeba", Name: "html", Type: "tree", Path: "files/html", Mode: "040000"},
+		{ID: "4535904260b1082e14f867f7a24fd8c21495bde3", Name: "index.html", Type: "blob", Path: "files/html/index.html", Mode: "100644"},
+	}
+	if !reflect.DeepEqual(want, tree) {
+		t.Errorf("Repositories.ListTree returned %+v, want %+v", tree, want)
+	}
+}
+
+func TestListTreeKeysetPagination(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	next := "https://gitlab.example.com/api/v4/projects/1/repository/tree?page_token=4535904260b1082e14f867f7a24fd8c21495bde3&pagination=keyset&per_page=1&recursive=true"
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tree", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/tree?page_token=a1e8f8d745cc87e3a9248358d9352bb7f9a0aeba&pagination=keyset&per_page=1&recursive=true")
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+		fmt.Fprint(w, `[{"id": "4535904260b1082e14f867f7a24fd8c21495bde3", "name": "index.html", "type": "blob", "path": "files/html/index.html", "mode": "100644"}]`)
+	})
+
+	opt := &ListTreeOptions{
+		ListOptions: ListOptions{PerPage: 1},
+		Recursive:   Bool(true),
+		Pagination:  String("keyset"),
+		PageToken:   String("a1e8f8d745cc87e3a9248358d9352bb7f9a0aeba"),
+	}
+	tree, resp, err := client.Repositories.ListTree(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.ListTree returned error: %v", err)
+	}
+
+	if len(tree) != 1 || tree[0].Path != "files/html/index.html" {
+		t.Errorf("Repositories.ListTree returned %+v", tree)
+	}
+	if resp.NextLink != next {
+		t.Errorf("Repositories.ListTree returned next link %q, want %q", resp.NextLink, next)
+	}
+}
+
+func TestRawBlobContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/blobs/4535904260b1082e14f867f7a24fd8c21495bde3/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "<html></html>")
+	})
+
+	b, _, err := client.Repositories.RawBlobContent(1, "4535904260b1082e14f867f7a24fd8c21495bde3")
+	if err != nil {
+		t.Fatalf("Repositories.RawBlobContent returned error: %v", err)
+	}
+
+	if want := "<html></html>"; string(b) != want {
+		t.Errorf("Repositories.RawBlobContent returned %q, want %q", b, want)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/archive.zip?path=services%2Fapi&sha=master")
+		w.Header().Set("Content-Disposition", `attachment; filename="project-master-services-api.zip"`)
+		fmt.Fprint(w, "archive")
+	})
+
+	opt := &ArchiveOptions{
+		Format: String("zip"),
+		Path:   String("services/api"),
+		SHA:    String("master"),
+	}
+	b, resp, err := client.Repositories.Archive(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.Archive returned error: %v", err)
+	}
+
+	if want := "archive"; string(b) != want {
+		t.Errorf("Repositories.Archive returned %q, want %q", b, want)
+	}
+	if want := "project-master-services-api.zip"; resp.Filename() != want {
+		t.Errorf("Repositories.Archive returned filename %q, want %q", resp.Filename(), want)
+	}
+}
+
+func TestStreamArchive(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.tar.bz2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "archive")
+	})
+
+	var b bytes.Buffer
+	resp, err := client.Repositories.StreamArchive(1, &b, &ArchiveOptions{Format: String("tar.bz2")})
+	if err != nil {
+		t.Fatalf("Repositories.StreamArchive returned error: %v", err)
+	}
+
+	if want := "archive"; b.String() != want {
+		t.Errorf("Repositories.StreamArchive returned %q, want %q", b.String(), want)
+	}
+	if resp.Filename() != "" {
+		t.Errorf("Repositories.StreamArchive returned filename %q, want empty", resp.Filename())
+	}
+}
+
+func TestArchiveInvalidFormat(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	_, _, err := client.Repositories.Archive(1, &ArchiveOptions{Format: String("rar")})
+	if err == nil {
+		t.Fatal("Repositories.Archive expected an error for an unsupported format")
+	}
+}
+
+func TestContributors(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/contributors?order_by=commits&page=1&per_page=20&sort=desc")
+		fmt.Fprint(w, `[
+			{"name": "Example User", "email": "example@example.com", "commits": 117, "additions": 0, "deletions": 0},
+			{"name": "Sample User", "email": "sample@example.com", "commits": 33, "additions": 12, "deletions": 4}
+		]`)
+	})
+
+	opt := &ListContributorsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 20},
+		OrderBy:     String("commits"),
+		Sort:        String("desc"),
+	}
+	contributors, _, err := client.Repositories.Contributors(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.Contributors returned error: %v", err)
+	}
+
+	want := []*Contributor{
+		{Name: "Example User", Email: "example@example.com", Commits: 117},
+		{Name: "Sample User", Email: "sample@example.com", Commits: 33, Additions: 12, Deletions: 4},
+	}
+	if !reflect.DeepEqual(want, contributors) {
+		t.Errorf("Repositories.Contributors returned %+v, want %+v", contributors, want)
+	}
+}
+
+func TestMergeBase(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/merge_base", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/merge_base?refs%5B%5D=release-1.0&refs%5B%5D=master")
+		fmt.Fprint(w, `{"id": "1a0b36b3cdad1d2ee32457c102a8c0b7056fa863", "short_id": "1a0b36b3", "title": "Initial commit"}`)
+	})
+
+	commit, _, err := client.Repositories.MergeBase(1, &MergeBaseOptions{Ref: []string{"release-1.0", "master"}})
+	if err != nil {
+		t.Fatalf("Repositories.MergeBase returned error: %v", err)
+	}
+
+	want := &Commit{ID: "1a0b36b3cdad1d2ee32457c102a8c0b7056fa863", ShortID: "1a0b36b3", Title: "Initial commit"}
+	if !reflect.DeepEqual(want, commit) {
+		t.Errorf("Repositories.MergeBase returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestMergeBaseTooFewRefs(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/merge_base", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Provide at least 2 refs"}`)
+	})
+
+	_, resp, err := client.Repositories.MergeBase(1, &MergeBaseOptions{Ref: []string{"master"}})
+	if err == nil {
+		t.Fatal("Repositories.MergeBase expected an error")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Repositories.MergeBase returned status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	want := "{message: Provide at least 2 refs}"
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Message != want {
+		t.Errorf("Repositories.MergeBase returned error %v, want message %q", err, want)
+	}
+}
+
+func TestUpdateSubmodule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/submodules/lib/modules/example", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testURL(t, r, "/api/v4/projects/1/repository/submodules/lib%2Fmodules%2Fexample")
+		testBody(t, r, `{"branch":"master","commit_sha":"3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88","commit_message":"Update submodule reference"}`)
+		fmt.Fprint(w, `{
+			"id": "ed899a2f4b50b4370feeea94676502b42383c746",
+			"short_id": "ed899a2f4b5",
+			"title": "Update submodule reference",
+			"author_name": "Dmitriy Zaporozhets",
+			"author_email": "dzaporozhets@sphereconsultinginc.com",
+			"message": "Update submodule reference",
+			"parent_ids": ["ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"],
+			"status": null
+		}`)
+	})
+
+	opt := &UpdateSubmoduleOptions{
+		Branch:        String("master"),
+		CommitSHA:     String("3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88"),
+		CommitMessage: String("Update submodule reference"),
+	}
+	commit, _, err := client.Repositories.UpdateSubmodule(1, "lib/modules/example", opt)
+	if err != nil {
+		t.Fatalf("Repositories.UpdateSubmodule returned error: %v", err)
+	}
+
+	want := &Commit{
+		ID:          "ed899a2f4b50b4370feeea94676502b42383c746",
+		ShortID:     "ed899a2f4b5",
+		Title:       "Update submodule reference",
+		AuthorName:  "Dmitriy Zaporozhets",
+		AuthorEmail: "dzaporozhets@sphereconsultinginc.com",
+		Message:     "Update submodule reference",
+		ParentIDs:   []string{"ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"},
+	}
+	if !reflect.DeepEqual(want, commit) {
+		t.Errorf("Repositories.UpdateSubmodule returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestAddChangelogData(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"version":"1.0.0","branch":"main","date":"2021-09-01T10:00:00Z","file":"CHANGELOG.md","from":"v0.9.0","to":"v1.0.0","trailer":"Type"}`)
+	})
+
+	date := time.Date(2021, 9, 1, 10, 0, 0, 0, time.UTC)
+	opt := &AddChangelogDataOptions{
+		Version: String("1.0.0"),
+		Branch:  String("main"),
+		Date:    &date,
+		File:    String("CHANGELOG.md"),
+		From:    String("v0.9.0"),
+		To:      String("v1.0.0"),
+		Trailer: String("Type"),
+	}
+	_, err := client.Repositories.AddChangelogData(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.AddChangelogData returned error: %v", err)
+	}
+}
+
+func TestGenerateChangelogData(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/changelog?config_file=.gitlab%2Fchangelog_config.yml&from=v0.9.0&version=1.0.0")
+		fmt.Fprint(w, `{"notes": "## 1.0.0 (2021-11-17)\n\n### feature (2 changes)\n\n- [Title 2](namespace13/project13@ad608eb642124f5b3944ac0ac772fecaf570a6bf)"}`)
+	})
+
+	opt := &GenerateChangelogDataOptions{
+		Version:    String("1.0.0"),
+		ConfigFile: String(".gitlab/changelog_config.yml"),
+		From:       String("v0.9.0"),
+	}
+	data, _, err := client.Repositories.GenerateChangelogData(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.GenerateChangelogData returned error: %v", err)
+	}
+
+	want := &ChangelogData{
+		Notes: "## 1.0.0 (2021-11-17)\n\n### feature (2 changes)\n\n- [Title 2](namespace13/project13@ad608eb642124f5b3944ac0ac772fecaf570a6bf)",
+	}
+	if !reflect.DeepEqual(want, data) {
+		t.Errorf("Repositories.GenerateChangelogData returned %+v, want %+v", data, want)
+	}
+}
@@ -19,9 +19,9 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
-	"time"
 )
 
 // RepositoryFilesService handles communication with the repository files
@@ -36,16 +36,17 @@ type RepositoryFilesService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repository_files.html
 type File struct {
-	FileName     string `json:"file_name"`
-	FilePath     string `json:"file_path"`
-	Size         int    `json:"size"`
-	Encoding     string `json:"encoding"`
-	Content      string `json:"content"`
-	Ref          string `json:"ref"`
-	BlobID       string `json:"blob_id"`
-	CommitID     string `json:"commit_id"`
-	SHA256       string `json:"content_sha256"`
-	LastCommitID string `json:"last_commit_id"`
+	FileName        string `json:"file_name"`
+	FilePath        string `json:"file_path"`
+	Size            int    `json:"size"`
+	Encoding        string `json:"encoding"`
+	Content         string `json:"content"`
+	ExecuteFilemode bool   `json:"execute_filemode"`
+	Ref             string `json:"ref"`
+	BlobID          string `json:"blob_id"`
+	CommitID        string `json:"commit_id"`
+	SHA256          string `json:"content_sha256"`
+	LastCommitID    string `json:"last_commit_id"`
 }
 
 func (r File) String() string {
@@ -149,18 +150,8 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repository_files.html
 type FileBlameRange struct {
-	Commit struct {
-		ID             string     `json:"id"`
-		ParentIDs      []string   `json:"parent_ids"`
-		Message        string     `json:"message"`
-		AuthoredDate   *time.Time `json:"authored_date"`
-		AuthorName     string     `json:"author_name"`
-		AuthorEmail    string     `json:"author_email"`
-		CommittedDate  *time.Time `json:"committed_date"`
-		CommitterName  string     `json:"committer_name"`
-		CommitterEmail string     `json:"committer_email"`
-	} `json:"commit"`
-	Lines []string `json:"lines"`
+	Commit Commit   `json:"commit"`
+	Lines  []string `json:"lines"`
 }
 
 func (b FileBlameRange) String() string {
@@ -172,7 +163,18 @@ func (b FileBlameRange) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#get-file-blame-from-repository
 type GetFileBlameOptions struct {
-	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+	Ref   *string         `url:"ref,omitempty" json:"ref,omitempty"`
+	Range *FileBlameLines `url:"range,omitempty" json:"range,omitempty"`
+}
+
+// FileBlameLines represents the range of lines to get blame information for.
+// Both Start and End are 1-based and inclusive.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repository_files.html#get-file-blame-from-repository
+type FileBlameLines struct {
+	Start *int `url:"start,omitempty" json:"start,omitempty"`
+	End   *int `url:"end,omitempty" json:"end,omitempty"`
 }
 
 // GetFileBlame allows you to receive blame information. Each blame range
@@ -211,9 +213,11 @@ func (s *RepositoryFilesService) GetFileBlame(pid interface{}, file string, opt
 // https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
 type GetRawFileOptions struct {
 	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+	LFS *bool   `url:"lfs,omitempty" json:"lfs,omitempty"`
 }
 
-// GetRawFile allows you to receive the raw file in repository.
+// GetRawFile allows you to receive the raw file in repository. The fileName
+// is the full path of the file, e.g. "cmd/main.go".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
@@ -242,6 +246,29 @@ func (s *RepositoryFilesService) GetRawFile(pid interface{}, fileName string, op
 	return f.Bytes(), resp, err
 }
 
+// StreamRawFile streams the raw file in repository to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
+func (s *RepositoryFilesService) StreamRawFile(pid interface{}, fileName string, w io.Writer, opt *GetRawFileOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/repository/files/%s/raw",
+		pathEscape(project),
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // FileInfo represents file details of a GitLab repository file.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repository_files.html
@@ -259,12 +286,14 @@ func (r FileInfo) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#create-new-file-in-repository
 type CreateFileOptions struct {
-	Branch        *string `url:"branch,omitempty" json:"branch,omitempty"`
-	Encoding      *string `url:"encoding,omitempty" json:"encoding,omitempty"`
-	AuthorEmail   *string `url:"author_email,omitempty" json:"author_email,omitempty"`
-	AuthorName    *string `url:"author_name,omitempty" json:"author_name,omitempty"`
-	Content       *string `url:"content,omitempty" json:"content,omitempty"`
-	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+	Branch          *string `url:"branch,omitempty" json:"branch,omitempty"`
+	StartBranch     *string `url:"start_branch,omitempty" json:"start_branch,omitempty"`
+	Encoding        *string `url:"encoding,omitempty" json:"encoding,omitempty"`
+	AuthorEmail     *string `url:"author_email,omitempty" json:"author_email,omitempty"`
+	AuthorName      *string `url:"author_name,omitempty" json:"author_name,omitempty"`
+	Content         *string `url:"content,omitempty" json:"content,omitempty"`
+	CommitMessage   *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+	ExecuteFilemode *bool   `url:"execute_filemode,omitempty" json:"execute_filemode,omitempty"`
 }
 
 // CreateFile creates a new file in a repository.
@@ -301,16 +330,20 @@ func (s *RepositoryFilesService) CreateFile(pid interface{}, fileName string, op
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#update-existing-file-in-repository
 type UpdateFileOptions struct {
-	Branch        *string `url:"branch,omitempty" json:"branch,omitempty"`
-	Encoding      *string `url:"encoding,omitempty" json:"encoding,omitempty"`
-	AuthorEmail   *string `url:"author_email,omitempty" json:"author_email,omitempty"`
-	AuthorName    *string `url:"author_name,omitempty" json:"author_name,omitempty"`
-	Content       *string `url:"content,omitempty" json:"content,omitempty"`
-	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
-	LastCommitID  *string `url:"last_commit_id,omitempty" json:"last_commit_id,omitempty"`
+	Branch          *string `url:"branch,omitempty" json:"branch,omitempty"`
+	StartBranch     *string `url:"start_branch,omitempty" json:"start_branch,omitempty"`
+	Encoding        *string `url:"encoding,omitempty" json:"encoding,omitempty"`
+	AuthorEmail     *string `url:"author_email,omitempty" json:"author_email,omitempty"`
+	AuthorName      *string `url:"author_name,omitempty" json:"author_name,omitempty"`
+	Content         *string `url:"content,omitempty" json:"content,omitempty"`
+	CommitMessage   *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+	LastCommitID    *string `url:"last_commit_id,omitempty" json:"last_commit_id,omitempty"`
+	ExecuteFilemode *bool   `url:"execute_filemode,omitempty" json:"execute_filemode,omitempty"`
 }
 
-// UpdateFile updates an existing file in a repository
+// UpdateFile updates an existing file in a repository. When LastCommitID is
+// set and the file was changed since that commit, GitLab refuses the update
+// with a 400 Bad Request.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#update-existing-file-in-repository
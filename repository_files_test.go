@@ -0,0 +1,238 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetRawFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/cmd/main.go/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/files/cmd%2Fmain.go/raw?lfs=true&ref=master")
+		fmt.Fprint(w, "package main\n")
+	})
+
+	b, _, err := client.RepositoryFiles.GetRawFile(1, "cmd/main.go", &GetRawFileOptions{Ref: String("master"), LFS: Bool(true)})
+	if err != nil {
+		t.Fatalf("RepositoryFiles.GetRawFile returned error: %v", err)
+	}
+
+	if want := "package main\n"; string(b) != want {
+		t.Errorf("RepositoryFiles.GetRawFile returned %q, want %q", b, want)
+	}
+}
+
+func TestGetRawFileWithSpaces(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/docs/user guide/getting started.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/files/docs%2Fuser%20guide%2Fgetting%20started.md/raw")
+		fmt.Fprint(w, "# Getting started")
+	})
+
+	b, _, err := client.RepositoryFiles.GetRawFile(1, "docs/user guide/getting started.md", nil)
+	if err != nil {
+		t.Fatalf("RepositoryFiles.GetRawFile returned error: %v", err)
+	}
+
+	if want := "# Getting started"; string(b) != want {
+		t.Errorf("RepositoryFiles.GetRawFile returned %q, want %q", b, want)
+	}
+}
+
+func TestStreamRawFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/assets/logo.png/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/files/assets%2Flogo.png/raw?ref=master")
+		fmt.Fprint(w, "binary")
+	})
+
+	var b bytes.Buffer
+	_, err := client.RepositoryFiles.StreamRawFile(1, "assets/logo.png", &b, &GetRawFileOptions{Ref: String("master")})
+	if err != nil {
+		t.Fatalf("RepositoryFiles.StreamRawFile returned error: %v", err)
+	}
+
+	if want := "binary"; b.String() != want {
+		t.Errorf("RepositoryFiles.StreamRawFile returned %q, want %q", b.String(), want)
+	}
+}
+
+func TestGetFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/app/models/key.rb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/files/app%2Fmodels%2Fkey.rb?ref=master")
+		fmt.Fprint(w, `{
+			"file_name": "key.rb",
+			"file_path": "app/models/key.rb",
+			"size": 1476,
+			"encoding": "base64",
+			"content": "IyA9PSBTY2hlbWEgSW5mb3...",
+			"content_sha256": "4c294617b60715c1d218e61164a3abd4808a4284cbc30e6728a01ad9aada4481",
+			"ref": "master",
+			"blob_id": "79f7bbd25901e8334750839545a9bd021f0e4c83",
+			"commit_id": "d5a3ff139356ce33e37e73add446f16869741b50",
+			"last_commit_id": "570e7b2abdd848b95f2f578043fc23bd6f6fd24d",
+			"execute_filemode": false
+		}`)
+	})
+
+	file, _, err := client.RepositoryFiles.GetFile(1, "app/models/key.rb", &GetFileOptions{Ref: String("master")})
+	if err != nil {
+		t.Fatalf("RepositoryFiles.GetFile returned error: %v", err)
+	}
+
+	want := &File{
+		FileName:     "key.rb",
+		FilePath:     "app/models/key.rb",
+		Size:         1476,
+		Encoding:     "base64",
+		Content:      "IyA9PSBTY2hlbWEgSW5mb3...",
+		SHA256:       "4c294617b60715c1d218e61164a3abd4808a4284cbc30e6728a01ad9aada4481",
+		Ref:          "master",
+		BlobID:       "79f7bbd25901e8334750839545a9bd021f0e4c83",
+		CommitID:     "d5a3ff139356ce33e37e73add446f16869741b50",
+		LastCommitID: "570e7b2abdd848b95f2f578043fc23bd6f6fd24d",
+	}
+	if !reflect.DeepEqual(want, file) {
+		t.Errorf("RepositoryFiles.GetFile returned %+v, want %+v", file, want)
+	}
+}
+
+func TestCreateFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/.gitlab-ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"ci-sync","start_branch":"master","author_email":"bot@example.com","author_name":"CI Sync","content":"include: []","commit_message":"Add CI config","execute_filemode":false}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"file_path": ".gitlab-ci.yml", "branch": "ci-sync"}`)
+	})
+
+	opt := &CreateFileOptions{
+		Branch:          String("ci-sync"),
+		StartBranch:     String("master"),
+		AuthorEmail:     String("bot@example.com"),
+		AuthorName:      String("CI Sync"),
+		Content:         String("include: []"),
+		CommitMessage:   String("Add CI config"),
+		ExecuteFilemode: Bool(false),
+	}
+	info, _, err := client.RepositoryFiles.CreateFile(1, ".gitlab-ci.yml", opt)
+	if err != nil {
+		t.Fatalf("RepositoryFiles.CreateFile returned error: %v", err)
+	}
+
+	want := &FileInfo{FilePath: ".gitlab-ci.yml", Branch: "ci-sync"}
+	if !reflect.DeepEqual(want, info) {
+		t.Errorf("RepositoryFiles.CreateFile returned %+v, want %+v", info, want)
+	}
+}
+
+func TestUpdateFileConflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/.gitlab-ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"branch":"master","content":"include: []","commit_message":"Update CI config","last_commit_id":"570e7b2abdd848b95f2f578043fc23bd6f6fd24d"}`)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "You are attempting to update a file that has changed since you started editing it."}`)
+	})
+
+	opt := &UpdateFileOptions{
+		Branch:        String("master"),
+		Content:       String("include: []"),
+		CommitMessage: String("Update CI config"),
+		LastCommitID:  String("570e7b2abdd848b95f2f578043fc23bd6f6fd24d"),
+	}
+	_, resp, err := client.RepositoryFiles.UpdateFile(1, ".gitlab-ci.yml", opt)
+	if err == nil {
+		t.Fatal("RepositoryFiles.UpdateFile expected an error")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("RepositoryFiles.UpdateFile returned status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestDeleteFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/config/old.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/repository/files/config%2Fold.yml?branch=master&commit_message=Remove+old+config")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &DeleteFileOptions{
+		Branch:        String("master"),
+		CommitMessage: String("Remove old config"),
+	}
+	_, err := client.RepositoryFiles.DeleteFile(1, "config/old.yml", opt)
+	if err != nil {
+		t.Fatalf("RepositoryFiles.DeleteFile returned error: %v", err)
+	}
+}
+
+func TestGetFileBlame(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/files/path/to/file.go/blame", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/files/path%2Fto%2Ffile.go/blame?range%5Bend%5D=2&range%5Bstart%5D=1&ref=master")
+		fmt.Fprint(w, `[{
+			"commit": {
+				"id": "d42409d56517157c48bf3bd97d3f75974dde19fb",
+				"message": "Add feature",
+				"parent_ids": ["cc6e14f9328fa6d7b5a0d3c30dc2002a3f2a3822"],
+				"author_name": "Venkatesh Thalluri",
+				"author_email": "venkatesh@example.com",
+				"committer_name": "Venkatesh Thalluri",
+				"committer_email": "venkatesh@example.com"
+			},
+			"lines": ["package main", ""]
+		}]`)
+	})
+
+	opt := &GetFileBlameOptions{
+		Ref:   String("master"),
+		Range: &FileBlameLines{Start: Int(1), End: Int(2)},
+	}
+	blame, _, err := client.RepositoryFiles.GetFileBlame(1, "path/to/file.go", opt)
+	if err != nil {
+		t.Fatalf("RepositoryFiles.GetFileBlame returned error: %v", err)
+	}
+
+	want := []*FileBlameRange{{
+		Commit: Commit{
+			ID:             "d42409d56517157c48bf3bd97d3f75974dde19fb",
+			Message:        "Add feature",
+			ParentIDs:      []string{"cc6e14f9328fa6d7b5a0d3c30dc2002a3f2a3822"},
+			AuthorName:     "Venkatesh Thalluri",
+			AuthorEmail:    "venkatesh@example.com",
+			CommitterName:  "Venkatesh Thalluri",
+			CommitterEmail: "venkatesh@example.com",
+		},
+		Lines: []string{"package main", ""},
+	}}
+	if !reflect.DeepEqual(want, blame) {
+		t.Errorf("RepositoryFiles.GetFileBlame returned %+v, want %+v", blame, want)
+	}
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -50,6 +51,10 @@ type Snippet struct {
 	CreatedAt *time.Time `json:"created_at"`
 	WebURL    string     `json:"web_url"`
 	RawURL    string     `json:"raw_url"`
+	Files     []struct {
+		Path   string `json:"path"`
+		RawURL string `json:"raw_url"`
+	} `json:"files"`
 }
 
 func (s Snippet) String() string {
@@ -105,11 +110,23 @@ func (s *SnippetsService) GetSnippet(snippet int, options ...RequestOptionFunc)
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/snippets.html#create-new-snippet
 type CreateSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                      `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                      `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                      `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                      `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue             `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       *[]*CreateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
+}
+
+// CreateSnippetFileOptions represents a file of a multi-file snippet. It is
+// used instead of the FileName and Content options when creating a snippet
+// with more than one file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#create-new-snippet
+type CreateSnippetFileOptions struct {
+	FilePath *string `url:"file_path,omitempty" json:"file_path,omitempty"`
+	Content  *string `url:"content,omitempty" json:"content,omitempty"`
 }
 
 // CreateSnippet creates a new snippet. The user must have permission
@@ -137,11 +154,25 @@ func (s *SnippetsService) CreateSnippet(opt *CreateSnippetOptions, options ...Re
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/snippets.html#update-snippet
 type UpdateSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                      `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                      `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                      `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                      `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue             `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       *[]*UpdateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
+}
+
+// UpdateSnippetFileOptions represents an action on a file of a multi-file
+// snippet. PreviousPath is required when moving a file and Content is not
+// used when deleting one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#update-snippet
+type UpdateSnippetFileOptions struct {
+	Action       *SnippetFileActionValue `url:"action,omitempty" json:"action,omitempty"`
+	FilePath     *string                 `url:"file_path,omitempty" json:"file_path,omitempty"`
+	Content      *string                 `url:"content,omitempty" json:"content,omitempty"`
+	PreviousPath *string                 `url:"previous_path,omitempty" json:"previous_path,omitempty"`
 }
 
 // UpdateSnippet updates an existing snippet. The user must have
@@ -204,6 +235,33 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...RequestOptionFu
 	return b.Bytes(), resp, err
 }
 
+// GetSnippetFileRaw returns the raw content of a single file of a snippet
+// at the given ref.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#snippet-repository-file-content
+func (s *SnippetsService) GetSnippetFileRaw(snippet int, ref, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	u := fmt.Sprintf(
+		"snippets/%d/files/%s/%s/raw",
+		snippet,
+		url.PathEscape(ref),
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
 // ExploreSnippetsOptions represents the available ExploreSnippets() options.
 //
 // GitLab API docs:
@@ -215,7 +273,37 @@ type ExploreSnippetsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/snippets.html#explore-all-public-snippets
 func (s *SnippetsService) ExploreSnippets(opt *ExploreSnippetsOptions, options ...RequestOptionFunc) ([]*Snippet, *Response, error) {
-	req, err := s.client.NewRequest("GET", "snippets/public", nil, options)
+	req, err := s.client.NewRequest("GET", "snippets/public", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ps []*Snippet
+	resp, err := s.client.Do(req, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ps, resp, err
+}
+
+// ListAllSnippetsOptions represents the available ListAllSnippets() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/snippets.html#list-all-snippets
+type ListAllSnippetsOptions struct {
+	ListOptions
+	CreatedAfter  *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+}
+
+// ListAllSnippets gets all snippets the current user has access to. For
+// administrators this includes the snippets of all users on the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/snippets.html#list-all-snippets
+func (s *SnippetsService) ListAllSnippets(opt *ListAllSnippetsOptions, options ...RequestOptionFunc) ([]*Snippet, *Response, error) {
+	req, err := s.client.NewRequest("GET", "snippets/all", opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,237 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListAllSnippets(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/snippets/all?created_after=2021-01-01T00%3A00%3A00Z&page=1&per_page=50")
+		fmt.Fprint(w, `[{"id":113,"title":"Dockerfile","file_name":"Dockerfile","raw_url":"https://gitlab.example.com/-/snippets/113/raw"}]`)
+	})
+
+	createdAfter := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListAllSnippetsOptions{
+		ListOptions:  ListOptions{Page: 1, PerPage: 50},
+		CreatedAfter: &createdAfter,
+	}
+	snippets, _, err := client.Snippets.ListAllSnippets(opt)
+	if err != nil {
+		t.Fatalf("Snippets.ListAllSnippets returned error: %v", err)
+	}
+
+	want := []*Snippet{{ID: 113, Title: "Dockerfile", FileName: "Dockerfile", RawURL: "https://gitlab.example.com/-/snippets/113/raw"}}
+	if !reflect.DeepEqual(want, snippets) {
+		t.Errorf("Snippets.ListAllSnippets returned %+v, want %+v", snippets, want)
+	}
+}
+
+func TestExploreSnippets(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/public", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/snippets/public?page=2&per_page=2")
+		fmt.Fprint(w, `[{"id":24,"title":"Hello world"}]`)
+	})
+
+	snippets, _, err := client.Snippets.ExploreSnippets(&ExploreSnippetsOptions{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatalf("Snippets.ExploreSnippets returned error: %v", err)
+	}
+
+	want := []*Snippet{{ID: 24, Title: "Hello world"}}
+	if !reflect.DeepEqual(want, snippets) {
+		t.Errorf("Snippets.ExploreSnippets returned %+v, want %+v", snippets, want)
+	}
+}
+
+func TestCreateSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"test.txt","file_name":"test.txt","content":"Hello world","visibility":"internal"}`)
+		fmt.Fprint(w, `{"id":1,"title":"test.txt","file_name":"test.txt"}`)
+	})
+
+	opt := &CreateSnippetOptions{
+		Title:      String("test.txt"),
+		FileName:   String("test.txt"),
+		Content:    String("Hello world"),
+		Visibility: Visibility(InternalVisibility),
+	}
+	snippet, _, err := client.Snippets.CreateSnippet(opt)
+	if err != nil {
+		t.Fatalf("Snippets.CreateSnippet returned error: %v", err)
+	}
+
+	want := &Snippet{ID: 1, Title: "test.txt", FileName: "test.txt"}
+	if !reflect.DeepEqual(want, snippet) {
+		t.Errorf("Snippets.CreateSnippet returned %+v, want %+v", snippet, want)
+	}
+}
+
+func TestCreateMultiFileSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"paste","visibility":"private","files":[{"file_path":"main.go","content":"package main"},{"file_path":"go.mod","content":"module paste"}]}`)
+		fmt.Fprint(w, `{"id":2,"title":"paste"}`)
+	})
+
+	opt := &CreateSnippetOptions{
+		Title:      String("paste"),
+		Visibility: Visibility(PrivateVisibility),
+		Files: &[]*CreateSnippetFileOptions{
+			{FilePath: String("main.go"), Content: String("package main")},
+			{FilePath: String("go.mod"), Content: String("module paste")},
+		},
+	}
+	snippet, _, err := client.Snippets.CreateSnippet(opt)
+	if err != nil {
+		t.Fatalf("Snippets.CreateSnippet returned error: %v", err)
+	}
+
+	want := &Snippet{ID: 2, Title: "paste"}
+	if !reflect.DeepEqual(want, snippet) {
+		t.Errorf("Snippets.CreateSnippet returned %+v, want %+v", snippet, want)
+	}
+}
+
+func TestSnippetContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/1/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "Hello world")
+	})
+
+	content, _, err := client.Snippets.SnippetContent(1)
+	if err != nil {
+		t.Fatalf("Snippets.SnippetContent returned error: %v", err)
+	}
+
+	if string(content) != "Hello world" {
+		t.Errorf("Snippets.SnippetContent returned %q, want %q", content, "Hello world")
+	}
+}
+
+func TestDeleteSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Snippets.DeleteSnippet(1)
+	if err != nil {
+		t.Fatalf("Snippets.DeleteSnippet returned error: %v", err)
+	}
+}
+
+func TestGetSnippetWithFiles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"title":"paste","files":[{"path":"main.go","raw_url":"https://gitlab.example.com/-/snippets/2/raw/main/main.go"},{"path":"cmd/run.go","raw_url":"https://gitlab.example.com/-/snippets/2/raw/main/cmd/run.go"}]}`)
+	})
+
+	snippet, _, err := client.Snippets.GetSnippet(2)
+	if err != nil {
+		t.Fatalf("Snippets.GetSnippet returned error: %v", err)
+	}
+
+	if len(snippet.Files) != 2 {
+		t.Fatalf("Snippets.GetSnippet returned %d files, want 2", len(snippet.Files))
+	}
+	if snippet.Files[1].Path != "cmd/run.go" {
+		t.Errorf("Files[1].Path is %s, want %s", snippet.Files[1].Path, "cmd/run.go")
+	}
+	if snippet.Files[1].RawURL != "https://gitlab.example.com/-/snippets/2/raw/main/cmd/run.go" {
+		t.Errorf("Files[1].RawURL is %s, want %s", snippet.Files[1].RawURL, "https://gitlab.example.com/-/snippets/2/raw/main/cmd/run.go")
+	}
+}
+
+func TestUpdateMultiFileSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"files":[{"action":"create","file_path":"README.md","content":"# paste"},{"action":"update","file_path":"main.go","content":"package main\n"},{"action":"delete","file_path":"go.sum"},{"action":"move","file_path":"cmd/run.go","previous_path":"run.go"}]}`)
+		fmt.Fprint(w, `{"id":2,"title":"paste"}`)
+	})
+
+	opt := &UpdateSnippetOptions{
+		Files: &[]*UpdateSnippetFileOptions{
+			{
+				Action:   SnippetFileAction(CreateSnippetFileAction),
+				FilePath: String("README.md"),
+				Content:  String("# paste"),
+			},
+			{
+				Action:   SnippetFileAction(UpdateSnippetFileAction),
+				FilePath: String("main.go"),
+				Content:  String("package main\n"),
+			},
+			{
+				Action:   SnippetFileAction(DeleteSnippetFileAction),
+				FilePath: String("go.sum"),
+			},
+			{
+				Action:       SnippetFileAction(MoveSnippetFileAction),
+				FilePath:     String("cmd/run.go"),
+				PreviousPath: String("run.go"),
+			},
+		},
+	}
+	snippet, _, err := client.Snippets.UpdateSnippet(2, opt)
+	if err != nil {
+		t.Fatalf("Snippets.UpdateSnippet returned error: %v", err)
+	}
+
+	want := &Snippet{ID: 2, Title: "paste"}
+	if !reflect.DeepEqual(want, snippet) {
+		t.Errorf("Snippets.UpdateSnippet returned %+v, want %+v", snippet, want)
+	}
+}
+
+func TestGetSnippetFileRaw(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/2/files/main/cmd/run.go/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/snippets/2/files/main/cmd%2Frun.go/raw")
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "package cmd")
+	})
+
+	content, _, err := client.Snippets.GetSnippetFileRaw(2, "main", "cmd/run.go")
+	if err != nil {
+		t.Fatalf("Snippets.GetSnippetFileRaw returned error: %v", err)
+	}
+
+	if string(content) != "package cmd" {
+		t.Errorf("Snippets.GetSnippetFileRaw returned %q, want %q", content, "package cmd")
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+)
+
+// SuggestionsService handles communication with the suggestions related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/suggestions.html
+type SuggestionsService struct {
+	client *Client
+}
+
+// Suggestion represents a GitLab suggestion.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/suggestions.html
+type Suggestion struct {
+	ID          int    `json:"id"`
+	FromLine    int    `json:"from_line"`
+	ToLine      int    `json:"to_line"`
+	Appliable   bool   `json:"appliable"`
+	Applied     bool   `json:"applied"`
+	FromContent string `json:"from_content"`
+	ToContent   string `json:"to_content"`
+}
+
+func (s Suggestion) String() string {
+	return Stringify(s)
+}
+
+// ApplySuggestionOptions represents the available ApplySuggestion() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/suggestions.html#applying-suggestions
+type ApplySuggestionOptions struct {
+	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+}
+
+// ApplySuggestion applies a suggested patch in a merge request. Users must
+// have at least the Developer role to perform such an action.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/suggestions.html#applying-suggestions
+func (s *SuggestionsService) ApplySuggestion(id int, opt *ApplySuggestionOptions, options ...RequestOptionFunc) (*Suggestion, *Response, error) {
+	u := fmt.Sprintf("suggestions/%d/apply", id)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suggestion := new(Suggestion)
+	resp, err := s.client.Do(req, suggestion)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suggestion, resp, err
+}
+
+// BatchApplySuggestionsOptions represents the available
+// BatchApplySuggestions() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/suggestions.html#applying-multiple-suggestions
+type BatchApplySuggestionsOptions struct {
+	IDs           []int   `url:"ids,omitempty" json:"ids,omitempty"`
+	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+}
+
+// BatchApplySuggestions applies multiple suggested patches in a merge request
+// in a single commit.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/suggestions.html#applying-multiple-suggestions
+func (s *SuggestionsService) BatchApplySuggestions(opt *BatchApplySuggestionsOptions, options ...RequestOptionFunc) ([]*Suggestion, *Response, error) {
+	req, err := s.client.NewRequest("PUT", "suggestions/batch_apply", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suggestions []*Suggestion
+	resp, err := s.client.Do(req, &suggestions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suggestions, resp, err
+}
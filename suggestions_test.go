@@ -0,0 +1,62 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestApplySuggestion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/suggestions/5/apply", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"commit_message":"Apply linter fix"}`)
+		fmt.Fprint(w, `{"id":5,"from_line":10,"to_line":10,"appliable":false,"applied":true,"from_content":"Original content\n","to_content":"Fixed content\n"}`)
+	})
+
+	opt := &ApplySuggestionOptions{CommitMessage: String("Apply linter fix")}
+	suggestion, _, err := client.Suggestions.ApplySuggestion(5, opt)
+	if err != nil {
+		t.Fatalf("Suggestions.ApplySuggestion returned error: %v", err)
+	}
+
+	want := &Suggestion{
+		ID:          5,
+		FromLine:    10,
+		ToLine:      10,
+		Applied:     true,
+		FromContent: "Original content\n",
+		ToContent:   "Fixed content\n",
+	}
+	if !reflect.DeepEqual(want, suggestion) {
+		t.Errorf("Suggestions.ApplySuggestion returned %+v, want %+v", suggestion, want)
+	}
+}
+
+func TestBatchApplySuggestions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/suggestions/batch_apply", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"ids":[5,6],"commit_message":"Apply all linter fixes"}`)
+		fmt.Fprint(w, `[{"id":5,"applied":true},{"id":6,"applied":true}]`)
+	})
+
+	opt := &BatchApplySuggestionsOptions{
+		IDs:           []int{5, 6},
+		CommitMessage: String("Apply all linter fixes"),
+	}
+	suggestions, _, err := client.Suggestions.BatchApplySuggestions(opt)
+	if err != nil {
+		t.Fatalf("Suggestions.BatchApplySuggestions returned error: %v", err)
+	}
+
+	want := []*Suggestion{{ID: 5, Applied: true}, {ID: 6, Applied: true}}
+	if !reflect.DeepEqual(want, suggestions) {
+		t.Errorf("Suggestions.BatchApplySuggestions returned %+v, want %+v", suggestions, want)
+	}
+}
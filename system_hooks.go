@@ -33,9 +33,14 @@ type SystemHooksService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/system_hooks.html
 type Hook struct {
-	ID        int        `json:"id"`
-	URL       string     `json:"url"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID                     int        `json:"id"`
+	URL                    string     `json:"url"`
+	CreatedAt              *time.Time `json:"created_at"`
+	PushEvents             bool       `json:"push_events"`
+	TagPushEvents          bool       `json:"tag_push_events"`
+	MergeRequestsEvents    bool       `json:"merge_requests_events"`
+	RepositoryUpdateEvents bool       `json:"repository_update_events"`
+	EnableSSLVerification  bool       `json:"enable_ssl_verification"`
 }
 
 func (h Hook) String() string {
@@ -61,6 +66,27 @@ func (s *SystemHooksService) ListHooks(options ...RequestOptionFunc) ([]*Hook, *
 	return h, resp, err
 }
 
+// GetHook gets a single system hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#get-system-hook
+func (s *SystemHooksService) GetHook(hook int, options ...RequestOptionFunc) (*Hook, *Response, error) {
+	u := fmt.Sprintf("hooks/%d", hook)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := new(Hook)
+	resp, err := s.client.Do(req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, err
+}
+
 // AddHookOptions represents the available AddHook() options.
 //
 // GitLab API docs:
@@ -110,14 +136,15 @@ func (h HookEvent) String() string {
 	return Stringify(h)
 }
 
-// TestHook tests a system hook.
+// TestHook tests a system hook by sending it a sample project_create event,
+// which is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/system_hooks.html#test-system-hook
 func (s *SystemHooksService) TestHook(hook int, options ...RequestOptionFunc) (*HookEvent, *Response, error) {
 	u := fmt.Sprintf("hooks/%d", hook)
 
-	req, err := s.client.NewRequest("GET", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
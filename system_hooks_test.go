@@ -0,0 +1,126 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListHooks(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"url":"https://gitlab.example.com/hook","created_at":"2016-10-31T12:32:15.192Z","push_events":true,"tag_push_events":false,"merge_requests_events":true,"repository_update_events":true,"enable_ssl_verification":true}]`)
+	})
+
+	hooks, _, err := client.SystemHooks.ListHooks()
+	if err != nil {
+		t.Fatalf("SystemHooks.ListHooks returned error: %v", err)
+	}
+
+	createdAt := time.Date(2016, 10, 31, 12, 32, 15, 192000000, time.UTC)
+	want := []*Hook{{
+		ID:                     1,
+		URL:                    "https://gitlab.example.com/hook",
+		CreatedAt:              &createdAt,
+		PushEvents:             true,
+		MergeRequestsEvents:    true,
+		RepositoryUpdateEvents: true,
+		EnableSSLVerification:  true,
+	}}
+	if !reflect.DeepEqual(want, hooks) {
+		t.Errorf("SystemHooks.ListHooks returned %+v, want %+v", hooks, want)
+	}
+}
+
+func TestGetHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"url":"https://gitlab.example.com/hook","push_events":true}`)
+	})
+
+	hook, _, err := client.SystemHooks.GetHook(1)
+	if err != nil {
+		t.Fatalf("SystemHooks.GetHook returned error: %v", err)
+	}
+
+	want := &Hook{ID: 1, URL: "https://gitlab.example.com/hook", PushEvents: true}
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("SystemHooks.GetHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestAddHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"url":"https://gitlab.example.com/hook","token":"secret","repository_update_events":true,"enable_ssl_verification":false}`)
+		fmt.Fprint(w, `{"id":2,"url":"https://gitlab.example.com/hook","repository_update_events":true}`)
+	})
+
+	opt := &AddHookOptions{
+		URL:                    String("https://gitlab.example.com/hook"),
+		Token:                  String("secret"),
+		RepositoryUpdateEvents: Bool(true),
+		EnableSSLVerification:  Bool(false),
+	}
+	hook, _, err := client.SystemHooks.AddHook(opt)
+	if err != nil {
+		t.Fatalf("SystemHooks.AddHook returned error: %v", err)
+	}
+
+	want := &Hook{ID: 2, URL: "https://gitlab.example.com/hook", RepositoryUpdateEvents: true}
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("SystemHooks.AddHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestTestHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"project_id":1,"owner_email":"example@gitlabhq.com","owner_name":"Someone","name":"Ruby","path":"ruby","event_name":"project_create"}`)
+	})
+
+	event, _, err := client.SystemHooks.TestHook(1)
+	if err != nil {
+		t.Fatalf("SystemHooks.TestHook returned error: %v", err)
+	}
+
+	want := &HookEvent{
+		EventName:  "project_create",
+		Name:       "Ruby",
+		Path:       "ruby",
+		ProjectID:  1,
+		OwnerName:  "Someone",
+		OwnerEmail: "example@gitlabhq.com",
+	}
+	if !reflect.DeepEqual(want, event) {
+		t.Errorf("SystemHooks.TestHook returned %+v, want %+v", event, want)
+	}
+}
+
+func TestDeleteHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.SystemHooks.DeleteHook(1)
+	if err != nil {
+		t.Fatalf("SystemHooks.DeleteHook returned error: %v", err)
+	}
+}
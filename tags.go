@@ -33,10 +33,12 @@ type TagsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/tags.html
 type Tag struct {
-	Commit  *Commit      `json:"commit"`
-	Release *ReleaseNote `json:"release"`
-	Name    string       `json:"name"`
-	Message string       `json:"message"`
+	Commit    *Commit      `json:"commit"`
+	Release   *ReleaseNote `json:"release"`
+	Name      string       `json:"name"`
+	Message   string       `json:"message"`
+	Target    string       `json:"target"`
+	Protected bool         `json:"protected"`
 }
 
 // ReleaseNote represents a GitLab version release.
@@ -51,7 +53,8 @@ func (t Tag) String() string {
 	return Stringify(t)
 }
 
-// ListTagsOptions represents the available ListTags() options.
+// ListTagsOptions represents the available ListTags() options. Search
+// supports "^term" and "term$" to find tags beginning or ending with term.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#list-project-repository-tags
@@ -105,8 +108,8 @@ func (s *TagsService) GetTag(pid interface{}, tag string, options ...RequestOpti
 		return nil, nil, err
 	}
 
-	var t *Tag
-	resp, err := s.client.Do(req, &t)
+	t := new(Tag)
+	resp, err := s.client.Do(req, t)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,10 +126,12 @@ type CreateTagOptions struct {
 	Ref     *string `url:"ref,omitempty" json:"ref,omitempty"`
 	Message *string `url:"message,omitempty" json:"message,omitempty"`
 	// ReleaseDescription parameter was deprecated in GitLab 11.7
-	ReleaseDescription *string `url:"release_description:omitempty" json:"release_description,omitempty"`
+	ReleaseDescription *string `url:"release_description,omitempty" json:"release_description,omitempty"`
 }
 
 // CreateTag creates a new tag in the repository that points to the supplied ref.
+// When a message is given an annotated tag is created, otherwise a lightweight
+// tag is created.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#create-a-new-tag
@@ -177,7 +182,7 @@ func (s *TagsService) DeleteTag(pid interface{}, tag string, options ...RequestO
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#create-a-new-release
 type CreateReleaseNoteOptions struct {
-	Description *string `url:"description:omitempty" json:"description,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
 }
 
 // CreateReleaseNote Add release notes to the existing git tag.
@@ -213,7 +218,7 @@ func (s *TagsService) CreateReleaseNote(pid interface{}, tag string, opt *Create
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#update-a-release
 type UpdateReleaseNoteOptions struct {
-	Description *string `url:"description:omitempty" json:"description,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
 }
 
 // UpdateReleaseNote Updates the release notes of a given release.
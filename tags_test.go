@@ -29,6 +29,113 @@ func TestTagsService_ListTags(t *testing.T) {
 	}
 }
 
+func TestTagsService_ListTagsWithSearch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/tags?order_by=updated&search=%5Ev1.&sort=desc")
+		if got := r.URL.Query().Get("search"); got != "^v1." {
+			t.Errorf("Tags.ListTags sent search %q, want %q", got, "^v1.")
+		}
+		fmt.Fprint(w, `[{"name": "v1.1.0"},{"name": "v1.0.0"}]`)
+	})
+
+	opt := &ListTagsOptions{
+		OrderBy: String("updated"),
+		Search:  String("^v1."),
+		Sort:    String("desc"),
+	}
+
+	tags, _, err := client.Tags.ListTags(1, opt)
+	if err != nil {
+		t.Fatalf("Tags.ListTags returned error: %v", err)
+	}
+
+	want := []*Tag{{Name: "v1.1.0"}, {Name: "v1.0.0"}}
+	if !reflect.DeepEqual(want, tags) {
+		t.Errorf("Tags.ListTags returned %+v, want %+v", tags, want)
+	}
+}
+
+func TestTagsService_GetTag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"name": "v1.0.0",
+			"message": "Release v1.0.0",
+			"target": "2695effb5807a22ff3d138d593fd856244e155e7",
+			"commit": {"id": "2695effb5807a22ff3d138d593fd856244e155e7", "short_id": "2695effb"},
+			"release": {"tag_name": "v1.0.0", "description": "Amazing release. Wow"},
+			"protected": true
+		}`)
+	})
+
+	tag, _, err := client.Tags.GetTag(1, "v1.0.0")
+	if err != nil {
+		t.Fatalf("Tags.GetTag returned error: %v", err)
+	}
+
+	want := &Tag{
+		Name:      "v1.0.0",
+		Message:   "Release v1.0.0",
+		Target:    "2695effb5807a22ff3d138d593fd856244e155e7",
+		Commit:    &Commit{ID: "2695effb5807a22ff3d138d593fd856244e155e7", ShortID: "2695effb"},
+		Release:   &ReleaseNote{TagName: "v1.0.0", Description: "Amazing release. Wow"},
+		Protected: true,
+	}
+	if !reflect.DeepEqual(want, tag) {
+		t.Errorf("Tags.GetTag returned %+v, want %+v", tag, want)
+	}
+}
+
+func TestTagsService_CreateTag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","ref":"master","message":"Release v1.0.0"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name": "v1.0.0", "message": "Release v1.0.0", "target": "a1b2c3"}`)
+	})
+
+	opt := &CreateTagOptions{
+		TagName: String("v1.0.0"),
+		Ref:     String("master"),
+		Message: String("Release v1.0.0"),
+	}
+
+	tag, _, err := client.Tags.CreateTag(1, opt)
+	if err != nil {
+		t.Fatalf("Tags.CreateTag returned error: %v", err)
+	}
+
+	want := &Tag{Name: "v1.0.0", Message: "Release v1.0.0", Target: "a1b2c3"}
+	if !reflect.DeepEqual(want, tag) {
+		t.Errorf("Tags.CreateTag returned %+v, want %+v", tag, want)
+	}
+}
+
+func TestTagsService_DeleteTag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags/release/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/repository/tags/release%2Fv1.0.0")
+	})
+
+	_, err := client.Tags.DeleteTag(1, "release/v1.0.0")
+	if err != nil {
+		t.Fatalf("Tags.DeleteTag returned error: %v", err)
+	}
+}
+
 func TestTagsService_CreateReleaseNote(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -18,12 +18,16 @@ type TodoAction string
 
 // The available todo actions.
 const (
-	TodoAssigned          TodoAction = "assigned"
-	TodoMentioned         TodoAction = "mentioned"
-	TodoBuildFailed       TodoAction = "build_failed"
-	TodoMarked            TodoAction = "marked"
-	TodoApprovalRequired  TodoAction = "approval_required"
-	TodoDirectlyAddressed TodoAction = "directly_addressed"
+	TodoAssigned              TodoAction = "assigned"
+	TodoMentioned             TodoAction = "mentioned"
+	TodoBuildFailed           TodoAction = "build_failed"
+	TodoMarked                TodoAction = "marked"
+	TodoApprovalRequired      TodoAction = "approval_required"
+	TodoDirectlyAddressed     TodoAction = "directly_addressed"
+	TodoUnmergeable           TodoAction = "unmergeable"
+	TodoReviewRequested       TodoAction = "review_requested"
+	TodoMergeTrainRemoved     TodoAction = "merge_train_removed"
+	TodoMemberAccessRequested TodoAction = "member_access_requested"
 )
 
 // TodoTarget represents a todo target of type Issue or MergeRequest
@@ -125,6 +129,7 @@ type ListTodosOptions struct {
 	Action    *TodoAction `url:"action,omitempty" json:"action,omitempty"`
 	AuthorID  *int        `url:"author_id,omitempty" json:"author_id,omitempty"`
 	ProjectID *int        `url:"project_id,omitempty" json:"project_id,omitempty"`
+	GroupID   *int        `url:"group_id,omitempty" json:"group_id,omitempty"`
 	State     *string     `url:"state,omitempty" json:"state,omitempty"`
 	Type      *string     `url:"type,omitempty" json:"type,omitempty"`
 }
@@ -164,6 +169,9 @@ func (s *TodosService) MarkTodoAsDone(id int, options ...RequestOptionFunc) (*Re
 }
 
 // MarkAllTodosAsDone marks all pending todos for the current user as done.
+// GitLab responds with 204 No Content and does not report how many todos
+// were marked; use the TotalItems of a preceding ListTodos() response with
+// the state set to pending if that count is needed.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/todos.html#mark-all-todos-as-done
 func (s *TodosService) MarkAllTodosAsDone(options ...RequestOptionFunc) (*Response, error) {
@@ -49,3 +49,33 @@ func TestMarkTodoAsDone(t *testing.T) {
 	_, err := client.Todos.MarkTodoAsDone(1)
 	require.NoError(t, err)
 }
+
+func TestListTodosWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/todos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/todos?action=review_requested&group_id=3&state=pending")
+		w.Write([]byte(`[{"id":7,"action_name":"review_requested","target_type":"MergeRequest","target_url":"https://gitlab.example.com/group/project/-/merge_requests/7","body":"Add draft notes","state":"pending"}]`))
+	})
+
+	action := TodoReviewRequested
+	opts := &ListTodosOptions{
+		Action:  &action,
+		GroupID: Int(3),
+		State:   String("pending"),
+	}
+	todos, _, err := client.Todos.ListTodos(opts)
+	require.NoError(t, err)
+
+	want := []*Todo{{
+		ID:         7,
+		ActionName: TodoReviewRequested,
+		TargetType: "MergeRequest",
+		TargetURL:  "https://gitlab.example.com/group/project/-/merge_requests/7",
+		Body:       "Add draft notes",
+		State:      "pending",
+	}}
+	require.Equal(t, want, todos)
+}
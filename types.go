@@ -61,6 +61,26 @@ func AccessLevel(v AccessLevelValue) *AccessLevelValue {
 	return p
 }
 
+// AvailabilityValue represents an availability value within GitLab.
+type AvailabilityValue string
+
+// List of available availability values.
+//
+// Undocumented, see code at:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/app/models/user_status.rb#L22
+const (
+	NotSet AvailabilityValue = "not_set"
+	Busy   AvailabilityValue = "busy"
+)
+
+// Availability is a helper routine that allocates a new AvailabilityValue
+// to store v and returns a pointer to it.
+func Availability(v AvailabilityValue) *AvailabilityValue {
+	p := new(AvailabilityValue)
+	*p = v
+	return p
+}
+
 // BuildStateValue represents a GitLab build state.
 type BuildStateValue string
 
@@ -84,6 +104,53 @@ func BuildState(v BuildStateValue) *BuildStateValue {
 	return p
 }
 
+// ClearStatusAfterValue represents the time after which a user status is
+// cleared automatically.
+type ClearStatusAfterValue string
+
+// List of available clear status after values.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#set-user-status
+const (
+	ClearStatusAfter30Minutes ClearStatusAfterValue = "30_minutes"
+	ClearStatusAfter3Hours    ClearStatusAfterValue = "3_hours"
+	ClearStatusAfter8Hours    ClearStatusAfterValue = "8_hours"
+	ClearStatusAfter1Day      ClearStatusAfterValue = "1_day"
+	ClearStatusAfter3Days     ClearStatusAfterValue = "3_days"
+	ClearStatusAfter7Days     ClearStatusAfterValue = "7_days"
+	ClearStatusAfter30Days    ClearStatusAfterValue = "30_days"
+)
+
+// ClearStatusAfter is a helper routine that allocates a new
+// ClearStatusAfterValue to store v and returns a pointer to it.
+func ClearStatusAfter(v ClearStatusAfterValue) *ClearStatusAfterValue {
+	p := new(ClearStatusAfterValue)
+	*p = v
+	return p
+}
+
+// CommitRefTypeValue represents the type of references a commit can be
+// pushed to.
+type CommitRefTypeValue string
+
+// List of available commit reference types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/commits.html#get-references-a-commit-is-pushed-to
+const (
+	AllCommitRefType    CommitRefTypeValue = "all"
+	BranchCommitRefType CommitRefTypeValue = "branch"
+	TagCommitRefType    CommitRefTypeValue = "tag"
+)
+
+// CommitRefType is a helper routine that allocates a new CommitRefTypeValue
+// to store v and returns a pointer to it.
+func CommitRefType(v CommitRefTypeValue) *CommitRefTypeValue {
+	p := new(CommitRefTypeValue)
+	*p = v
+	return p
+}
+
 // DeploymentStatusValue represents a Gitlab deployment status.
 type DeploymentStatusValue string
 
@@ -94,6 +161,7 @@ const (
 	DeploymentStatusSuccess  DeploymentStatusValue = "success"
 	DeploymentStatusFailed   DeploymentStatusValue = "failed"
 	DeploymentStatusCanceled DeploymentStatusValue = "canceled"
+	DeploymentStatusBlocked  DeploymentStatusValue = "blocked"
 )
 
 // DeploymentStatus is a helper routine that allocates a new
@@ -104,6 +172,57 @@ func DeploymentStatus(v DeploymentStatusValue) *DeploymentStatusValue {
 	return p
 }
 
+// EnvironmentTierValue represents the tier of a GitLab environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/ci/environments/index.html#deployment-tier-of-environments
+type EnvironmentTierValue string
+
+// List of available environment tiers.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/ci/environments/index.html#deployment-tier-of-environments
+const (
+	ProductionEnvironmentTier  EnvironmentTierValue = "production"
+	StagingEnvironmentTier     EnvironmentTierValue = "staging"
+	TestingEnvironmentTier     EnvironmentTierValue = "testing"
+	DevelopmentEnvironmentTier EnvironmentTierValue = "development"
+	OtherEnvironmentTier       EnvironmentTierValue = "other"
+)
+
+// EnvironmentTier is a helper routine that allocates a new
+// EnvironmentTierValue to store v and returns a pointer to it.
+func EnvironmentTier(v EnvironmentTierValue) *EnvironmentTierValue {
+	p := new(EnvironmentTierValue)
+	*p = v
+	return p
+}
+
+// LinkTypeValue represents a release link type.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html#create-a-link
+type LinkTypeValue string
+
+// List of available release link types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html#create-a-link
+const (
+	ImageLinkType   LinkTypeValue = "image"
+	OtherLinkType   LinkTypeValue = "other"
+	PackageLinkType LinkTypeValue = "package"
+	RunbookLinkType LinkTypeValue = "runbook"
+)
+
+// LinkType is a helper routine that allocates a new LinkTypeValue
+// to store v and returns a pointer to it.
+func LinkType(v LinkTypeValue) *LinkTypeValue {
+	p := new(LinkTypeValue)
+	*p = v
+	return p
+}
+
 // ISOTime represents an ISO 8601 formatted date
 type ISOTime time.Time
 
@@ -222,6 +341,59 @@ func NotificationLevel(v NotificationLevelValue) *NotificationLevelValue {
 	return p
 }
 
+// RepositoryStorageMoveStateValue represents the state of a repository
+// storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type RepositoryStorageMoveStateValue string
+
+// List of available repository storage move states.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+const (
+	RepositoryStorageMoveInitial   RepositoryStorageMoveStateValue = "initial"
+	RepositoryStorageMoveScheduled RepositoryStorageMoveStateValue = "scheduled"
+	RepositoryStorageMoveStarted   RepositoryStorageMoveStateValue = "started"
+	RepositoryStorageMoveFinished  RepositoryStorageMoveStateValue = "finished"
+	RepositoryStorageMoveFailed    RepositoryStorageMoveStateValue = "failed"
+)
+
+// RepositoryStorageMoveState is a helper routine that allocates a new
+// RepositoryStorageMoveStateValue to store v and returns a pointer to it.
+func RepositoryStorageMoveState(v RepositoryStorageMoveStateValue) *RepositoryStorageMoveStateValue {
+	p := new(RepositoryStorageMoveStateValue)
+	*p = v
+	return p
+}
+
+// SnippetFileActionValue represents the action to perform on a file when
+// updating a multi-file snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#update-snippet
+type SnippetFileActionValue string
+
+// List of available snippet file actions.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#update-snippet
+const (
+	CreateSnippetFileAction SnippetFileActionValue = "create"
+	UpdateSnippetFileAction SnippetFileActionValue = "update"
+	DeleteSnippetFileAction SnippetFileActionValue = "delete"
+	MoveSnippetFileAction   SnippetFileActionValue = "move"
+)
+
+// SnippetFileAction is a helper routine that allocates a new
+// SnippetFileActionValue to store v and returns a pointer to it.
+func SnippetFileAction(v SnippetFileActionValue) *SnippetFileActionValue {
+	p := new(SnippetFileActionValue)
+	*p = v
+	return p
+}
+
 // VisibilityValue represents a visibility level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/
@@ -330,6 +502,67 @@ func MergeMethod(v MergeMethodValue) *MergeMethodValue {
 	return p
 }
 
+// PackageTypeValue represents a GitLab package type.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/packages.html#within-a-project
+type PackageTypeValue string
+
+// List of available package types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/packages.html#within-a-project
+const (
+	ComposerPackageType PackageTypeValue = "composer"
+	ConanPackageType    PackageTypeValue = "conan"
+	GenericPackageType  PackageTypeValue = "generic"
+	GolangPackageType   PackageTypeValue = "golang"
+	HelmPackageType     PackageTypeValue = "helm"
+	MavenPackageType    PackageTypeValue = "maven"
+	NPMPackageType      PackageTypeValue = "npm"
+	NugetPackageType    PackageTypeValue = "nuget"
+	PyPIPackageType     PackageTypeValue = "pypi"
+)
+
+// PackageType is a helper routine that allocates a new PackageTypeValue
+// to store v and returns a pointer to it.
+func PackageType(v PackageTypeValue) *PackageTypeValue {
+	p := new(PackageTypeValue)
+	*p = v
+	return p
+}
+
+// PipelineSourceValue represents the source that triggered a pipeline.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/ci/pipelines.html
+type PipelineSourceValue string
+
+// List of available pipeline sources.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/ci/pipelines.html
+const (
+	APIPipelineSource                      PipelineSourceValue = "api"
+	ChatPipelineSource                     PipelineSourceValue = "chat"
+	ExternalPipelineSource                 PipelineSourceValue = "external"
+	ExternalPullRequestEventPipelineSource PipelineSourceValue = "external_pull_request_event"
+	MergeRequestEventPipelineSource        PipelineSourceValue = "merge_request_event"
+	ParentPipelineSource                   PipelineSourceValue = "parent_pipeline"
+	PipelinePipelineSource                 PipelineSourceValue = "pipeline"
+	PushPipelineSource                     PipelineSourceValue = "push"
+	SchedulePipelineSource                 PipelineSourceValue = "schedule"
+	TriggerPipelineSource                  PipelineSourceValue = "trigger"
+	WebPipelineSource                      PipelineSourceValue = "web"
+	WebIDEPipelineSource                   PipelineSourceValue = "webide"
+)
+
+// PipelineSource is a helper routine that allocates a new PipelineSourceValue
+// to store v and returns a pointer to it.
+func PipelineSource(v PipelineSourceValue) *PipelineSourceValue {
+	p := new(PipelineSourceValue)
+	*p = v
+	return p
+}
+
 // EventTypeValue represents actions type for contribution events
 type EventTypeValue string
 
@@ -383,6 +616,14 @@ func Int(v int) *int {
 	return p
 }
 
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 {
+	p := new(int64)
+	*p = v
+	return p
+}
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string {
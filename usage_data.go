@@ -0,0 +1,146 @@
+//
+// Copyright 2021, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// UsageDataService handles communication with the service ping related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/usage_data.html
+type UsageDataService struct {
+	client *Client
+}
+
+// ServicePingData represents a service ping payload. The payload is large
+// and its layout changes between GitLab versions, so only the top-level
+// keys are exposed as fields. The complete payload is available in Raw.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-data
+type ServicePingData struct {
+	RecordedAt       *time.Time       `json:"recorded_at"`
+	UUID             string           `json:"uuid"`
+	Hostname         string           `json:"hostname"`
+	Version          string           `json:"version"`
+	InstallationType string           `json:"installation_type"`
+	Edition          string           `json:"edition"`
+	Counts           map[string]int64 `json:"counts"`
+	Raw              json.RawMessage  `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *ServicePingData) UnmarshalJSON(data []byte) error {
+	type alias ServicePingData
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+
+	d.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// GetServicePing gets the service ping payload the instance would send.
+// Authorization as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-data
+func (s *UsageDataService) GetServicePing(options ...RequestOptionFunc) (*ServicePingData, *Response, error) {
+	req, err := s.client.NewRequest("GET", "usage_data/service_ping", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sp := new(ServicePingData)
+	resp, err := s.client.Do(req, sp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sp, resp, err
+}
+
+// GetMetricDefinitionsAsYAML gets all the service ping metric definitions
+// as a single YAML document.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-metric-definitions-as-a-single-yaml-file
+func (s *UsageDataService) GetMetricDefinitionsAsYAML(options ...RequestOptionFunc) ([]byte, *Response, error) {
+	req, err := s.client.NewRequest("GET", "usage_data/metric_definitions", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// ServicePingQueries represents the SQL queries used to compute a service
+// ping payload. Like ServicePingData, only the top-level keys are exposed
+// as fields and the complete payload is available in Raw.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-sql-queries
+type ServicePingQueries struct {
+	RecordedAt       *time.Time      `json:"recorded_at"`
+	UUID             string          `json:"uuid"`
+	Hostname         string          `json:"hostname"`
+	Version          string          `json:"version"`
+	InstallationType string          `json:"installation_type"`
+	Edition          string          `json:"edition"`
+	Raw              json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (q *ServicePingQueries) UnmarshalJSON(data []byte) error {
+	type alias ServicePingQueries
+	if err := json.Unmarshal(data, (*alias)(q)); err != nil {
+		return err
+	}
+
+	q.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// GetQueries gets the SQL queries used to compute the service ping payload.
+// Authorization as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-sql-queries
+func (s *UsageDataService) GetQueries(options ...RequestOptionFunc) (*ServicePingQueries, *Response, error) {
+	req, err := s.client.NewRequest("GET", "usage_data/queries", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sq := new(ServicePingQueries)
+	resp, err := s.client.Do(req, sq)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sq, resp, err
+}
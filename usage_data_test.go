@@ -0,0 +1,84 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetServicePing(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	payload := `{"recorded_at":"2021-03-04T11:20:35.000Z","uuid":"0000-1111","hostname":"gitlab.example.com","version":"15.4.0","edition":"EE","counts":{"assignee_lists":0,"boards":3,"ci_builds":11417},"license":{"md5":"abc"}}`
+	mux.HandleFunc("/api/v4/usage_data/service_ping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, payload)
+	})
+
+	sp, _, err := client.UsageData.GetServicePing()
+	if err != nil {
+		t.Fatalf("UsageData.GetServicePing returned error: %v", err)
+	}
+
+	recordedAt := time.Date(2021, 3, 4, 11, 20, 35, 0, time.UTC)
+	if !reflect.DeepEqual(&recordedAt, sp.RecordedAt) {
+		t.Errorf("RecordedAt is %v, want %v", sp.RecordedAt, recordedAt)
+	}
+	if sp.UUID != "0000-1111" || sp.Version != "15.4.0" || sp.Edition != "EE" {
+		t.Errorf("UsageData.GetServicePing returned %+v", sp)
+	}
+
+	wantCounts := map[string]int64{"assignee_lists": 0, "boards": 3, "ci_builds": 11417}
+	if !reflect.DeepEqual(wantCounts, sp.Counts) {
+		t.Errorf("Counts is %v, want %v", sp.Counts, wantCounts)
+	}
+	if string(sp.Raw) != payload {
+		t.Errorf("Raw is %s, want %s", sp.Raw, payload)
+	}
+}
+
+func TestGetMetricDefinitionsAsYAML(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	yaml := "---\n- key_path: redis_hll_counters.search.i_search_paid_monthly\n  value_type: number\n"
+	mux.HandleFunc("/api/v4/usage_data/metric_definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, yaml)
+	})
+
+	definitions, _, err := client.UsageData.GetMetricDefinitionsAsYAML()
+	if err != nil {
+		t.Fatalf("UsageData.GetMetricDefinitionsAsYAML returned error: %v", err)
+	}
+
+	if string(definitions) != yaml {
+		t.Errorf("UsageData.GetMetricDefinitionsAsYAML returned %q, want %q", definitions, yaml)
+	}
+}
+
+func TestGetQueries(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	payload := `{"recorded_at":"2021-03-23T06:31:21.000Z","uuid":null,"version":"13.11.0","counts":{"boards":"SELECT COUNT(\"boards\".\"id\") FROM \"boards\""}}`
+	mux.HandleFunc("/api/v4/usage_data/queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, payload)
+	})
+
+	sq, _, err := client.UsageData.GetQueries()
+	if err != nil {
+		t.Fatalf("UsageData.GetQueries returned error: %v", err)
+	}
+
+	if sq.Version != "13.11.0" {
+		t.Errorf("Version is %s, want %s", sq.Version, "13.11.0")
+	}
+	if string(sq.Raw) != payload {
+		t.Errorf("Raw is %s, want %s", sq.Raw, payload)
+	}
+}
@@ -19,15 +19,20 @@ package gitlab
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
 // List a couple of standard errors.
 var (
 	ErrUserActivatePrevented   = errors.New("Cannot activate a user that is blocked by admin or by LDAP synchronization")
+	ErrUserApprovePrevented    = errors.New("Cannot approve a user that is blocked or is not pending approval")
+	ErrUserBanPrevented        = errors.New("Cannot ban a user that is not active")
 	ErrUserBlockPrevented      = errors.New("Cannot block a user that is already blocked by LDAP synchronization")
-	ErrUserDeactivatePrevented = errors.New("Cannot deactivate a user that is blocked by admin or by LDAP synchronization, or that has any activity in past 180 days")
+	ErrUserDeactivatePrevented = errors.New("Cannot deactivate a user that is blocked by admin or by LDAP synchronization, or that has any activity in past 90 days")
 	ErrUserNotFound            = errors.New("User does not exist")
+	ErrUserRejectPrevented     = errors.New("Cannot reject a user that is not pending approval")
+	ErrUserUnbanPrevented      = errors.New("Cannot unban a user that is not banned")
 	ErrUserUnblockPrevented    = errors.New("Cannot unblock a user that is blocked by LDAP synchronization")
 )
 
@@ -87,6 +92,8 @@ type User struct {
 	External                  bool               `json:"external"`
 	PrivateProfile            bool               `json:"private_profile"`
 	SharedRunnersMinutesLimit int                `json:"shared_runners_minutes_limit"`
+	Note                      string             `json:"note"`
+	UsingLicenseSeat          bool               `json:"using_license_seat"`
 	CustomAttributes          []*CustomAttribute `json:"custom_attributes"`
 }
 
@@ -114,10 +121,18 @@ type ListUsersOptions struct {
 	OrderBy              *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort                 *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	External             *bool      `url:"external,omitempty" json:"external,omitempty"`
+	ExcludeInternal      *bool      `url:"exclude_internal,omitempty" json:"exclude_internal,omitempty"`
+	ExcludeExternal      *bool      `url:"exclude_external,omitempty" json:"exclude_external,omitempty"`
+	WithoutProjectBots   *bool      `url:"without_project_bots,omitempty" json:"without_project_bots,omitempty"`
+	Admins               *bool      `url:"admins,omitempty" json:"admins,omitempty"`
+	TwoFactor            *string    `url:"two_factor,omitempty" json:"two_factor,omitempty"`
+	PendingApproval      *bool      `url:"pending_approval,omitempty" json:"pending_approval,omitempty"`
 	WithCustomAttributes *bool      `url:"with_custom_attributes,omitempty" json:"with_custom_attributes,omitempty"`
 }
 
-// ListUsers gets a list of users.
+// ListUsers gets a list of users. The total number of matching users is
+// available in Response.TotalItems, so requesting a single item is enough
+// to count them.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-users
 func (s *UsersService) ListUsers(opt *ListUsersOptions, options ...RequestOptionFunc) ([]*User, *Response, error) {
@@ -159,30 +174,35 @@ func (s *UsersService) GetUser(user int, options ...RequestOptionFunc) (*User, *
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#user-creation
 type CreateUserOptions struct {
-	Email               *string `url:"email,omitempty" json:"email,omitempty"`
-	Password            *string `url:"password,omitempty" json:"password,omitempty"`
-	ResetPassword       *bool   `url:"reset_password,omitempty" json:"reset_password,omitempty"`
-	ForceRandomPassword *bool   `url:"force_random_password,omitempty" json:"force_random_password,omitempty"`
-	Username            *string `url:"username,omitempty" json:"username,omitempty"`
-	Name                *string `url:"name,omitempty" json:"name,omitempty"`
-	Skype               *string `url:"skype,omitempty" json:"skype,omitempty"`
-	Linkedin            *string `url:"linkedin,omitempty" json:"linkedin,omitempty"`
-	Twitter             *string `url:"twitter,omitempty" json:"twitter,omitempty"`
-	WebsiteURL          *string `url:"website_url,omitempty" json:"website_url,omitempty"`
-	Organization        *string `url:"organization,omitempty" json:"organization,omitempty"`
-	ProjectsLimit       *int    `url:"projects_limit,omitempty" json:"projects_limit,omitempty"`
-	ExternUID           *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
-	Provider            *string `url:"provider,omitempty" json:"provider,omitempty"`
-	Bio                 *string `url:"bio,omitempty" json:"bio,omitempty"`
-	Location            *string `url:"location,omitempty" json:"location,omitempty"`
-	Admin               *bool   `url:"admin,omitempty" json:"admin,omitempty"`
-	CanCreateGroup      *bool   `url:"can_create_group,omitempty" json:"can_create_group,omitempty"`
-	SkipConfirmation    *bool   `url:"skip_confirmation,omitempty" json:"skip_confirmation,omitempty"`
-	External            *bool   `url:"external,omitempty" json:"external,omitempty"`
-	PrivateProfile      *bool   `url:"private_profile,omitempty" json:"private_profile,omitempty"`
+	Email                     *string `url:"email,omitempty" json:"email,omitempty"`
+	Password                  *string `url:"password,omitempty" json:"password,omitempty"`
+	ResetPassword             *bool   `url:"reset_password,omitempty" json:"reset_password,omitempty"`
+	ForceRandomPassword       *bool   `url:"force_random_password,omitempty" json:"force_random_password,omitempty"`
+	Username                  *string `url:"username,omitempty" json:"username,omitempty"`
+	Name                      *string `url:"name,omitempty" json:"name,omitempty"`
+	Skype                     *string `url:"skype,omitempty" json:"skype,omitempty"`
+	Linkedin                  *string `url:"linkedin,omitempty" json:"linkedin,omitempty"`
+	Twitter                   *string `url:"twitter,omitempty" json:"twitter,omitempty"`
+	WebsiteURL                *string `url:"website_url,omitempty" json:"website_url,omitempty"`
+	Organization              *string `url:"organization,omitempty" json:"organization,omitempty"`
+	ProjectsLimit             *int    `url:"projects_limit,omitempty" json:"projects_limit,omitempty"`
+	ExternUID                 *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
+	Provider                  *string `url:"provider,omitempty" json:"provider,omitempty"`
+	Bio                       *string `url:"bio,omitempty" json:"bio,omitempty"`
+	Location                  *string `url:"location,omitempty" json:"location,omitempty"`
+	Admin                     *bool   `url:"admin,omitempty" json:"admin,omitempty"`
+	CanCreateGroup            *bool   `url:"can_create_group,omitempty" json:"can_create_group,omitempty"`
+	SkipConfirmation          *bool   `url:"skip_confirmation,omitempty" json:"skip_confirmation,omitempty"`
+	External                  *bool   `url:"external,omitempty" json:"external,omitempty"`
+	PrivateProfile            *bool   `url:"private_profile,omitempty" json:"private_profile,omitempty"`
+	Note                      *string `url:"note,omitempty" json:"note,omitempty"`
+	ColorSchemeID             *int    `url:"color_scheme_id,omitempty" json:"color_scheme_id,omitempty"`
+	SharedRunnersMinutesLimit *int    `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
 }
 
 // CreateUser creates a new user. Note only administrators can create new users.
+// Setting Provider and ExternUID binds the user to an external identity at
+// creation time.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#user-creation
 func (s *UsersService) CreateUser(opt *CreateUserOptions, options ...RequestOptionFunc) (*User, *Response, error) {
@@ -204,25 +224,28 @@ func (s *UsersService) CreateUser(opt *CreateUserOptions, options ...RequestOpti
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#user-modification
 type ModifyUserOptions struct {
-	Email              *string `url:"email,omitempty" json:"email,omitempty"`
-	Password           *string `url:"password,omitempty" json:"password,omitempty"`
-	Username           *string `url:"username,omitempty" json:"username,omitempty"`
-	Name               *string `url:"name,omitempty" json:"name,omitempty"`
-	Skype              *string `url:"skype,omitempty" json:"skype,omitempty"`
-	Linkedin           *string `url:"linkedin,omitempty" json:"linkedin,omitempty"`
-	Twitter            *string `url:"twitter,omitempty" json:"twitter,omitempty"`
-	WebsiteURL         *string `url:"website_url,omitempty" json:"website_url,omitempty"`
-	Organization       *string `url:"organization,omitempty" json:"organization,omitempty"`
-	ProjectsLimit      *int    `url:"projects_limit,omitempty" json:"projects_limit,omitempty"`
-	ExternUID          *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
-	Provider           *string `url:"provider,omitempty" json:"provider,omitempty"`
-	Bio                *string `url:"bio,omitempty" json:"bio,omitempty"`
-	Location           *string `url:"location,omitempty" json:"location,omitempty"`
-	Admin              *bool   `url:"admin,omitempty" json:"admin,omitempty"`
-	CanCreateGroup     *bool   `url:"can_create_group,omitempty" json:"can_create_group,omitempty"`
-	SkipReconfirmation *bool   `url:"skip_reconfirmation,omitempty" json:"skip_reconfirmation,omitempty"`
-	External           *bool   `url:"external,omitempty" json:"external,omitempty"`
-	PrivateProfile     *bool   `url:"private_profile,omitempty" json:"private_profile,omitempty"`
+	Email                     *string `url:"email,omitempty" json:"email,omitempty"`
+	Password                  *string `url:"password,omitempty" json:"password,omitempty"`
+	Username                  *string `url:"username,omitempty" json:"username,omitempty"`
+	Name                      *string `url:"name,omitempty" json:"name,omitempty"`
+	Skype                     *string `url:"skype,omitempty" json:"skype,omitempty"`
+	Linkedin                  *string `url:"linkedin,omitempty" json:"linkedin,omitempty"`
+	Twitter                   *string `url:"twitter,omitempty" json:"twitter,omitempty"`
+	WebsiteURL                *string `url:"website_url,omitempty" json:"website_url,omitempty"`
+	Organization              *string `url:"organization,omitempty" json:"organization,omitempty"`
+	ProjectsLimit             *int    `url:"projects_limit,omitempty" json:"projects_limit,omitempty"`
+	ExternUID                 *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
+	Provider                  *string `url:"provider,omitempty" json:"provider,omitempty"`
+	Bio                       *string `url:"bio,omitempty" json:"bio,omitempty"`
+	Location                  *string `url:"location,omitempty" json:"location,omitempty"`
+	Admin                     *bool   `url:"admin,omitempty" json:"admin,omitempty"`
+	CanCreateGroup            *bool   `url:"can_create_group,omitempty" json:"can_create_group,omitempty"`
+	SkipReconfirmation        *bool   `url:"skip_reconfirmation,omitempty" json:"skip_reconfirmation,omitempty"`
+	External                  *bool   `url:"external,omitempty" json:"external,omitempty"`
+	PrivateProfile            *bool   `url:"private_profile,omitempty" json:"private_profile,omitempty"`
+	Note                      *string `url:"note,omitempty" json:"note,omitempty"`
+	ColorSchemeID             *int    `url:"color_scheme_id,omitempty" json:"color_scheme_id,omitempty"`
+	SharedRunnersMinutesLimit *int    `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
 }
 
 // ModifyUser modifies an existing user. Only administrators can change attributes
@@ -290,6 +313,7 @@ type SSHKey struct {
 	Title     string     `json:"title"`
 	Key       string     `json:"key"`
 	CreatedAt *time.Time `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
 }
 
 // ListSSHKeys gets a list of currently authenticated user's SSH keys.
@@ -360,10 +384,11 @@ func (s *UsersService) GetSSHKey(key int, options ...RequestOptionFunc) (*SSHKey
 
 // AddSSHKeyOptions represents the available AddSSHKey() options.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#add-ssh-key
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#add-ssh-key
 type AddSSHKeyOptions struct {
-	Title *string `url:"title,omitempty" json:"title,omitempty"`
-	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Title     *string    `url:"title,omitempty" json:"title,omitempty"`
+	Key       *string    `url:"key,omitempty" json:"key,omitempty"`
+	ExpiresAt *time.Time `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 }
 
 // AddSSHKey creates a new key owned by the currently authenticated user.
@@ -438,124 +463,504 @@ func (s *UsersService) DeleteSSHKeyForUser(user, key int, options ...RequestOpti
 	return s.client.Do(req, nil)
 }
 
+// GPGKey represents a GPG key.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys
+type GPGKey struct {
+	ID        int        `json:"id"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// ListGPGKeys gets a list of currently authenticated user's GPG keys.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys
+func (s *UsersService) ListGPGKeys(options ...RequestOptionFunc) ([]*GPGKey, *Response, error) {
+	req, err := s.client.NewRequest("GET", "user/gpg_keys", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ks []*GPGKey
+	resp, err := s.client.Do(req, &ks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ks, resp, err
+}
+
+// GetGPGKey gets a specific GPG key of currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#get-a-specific-gpg-key
+func (s *UsersService) GetGPGKey(key int, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("user/gpg_keys/%d", key)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// AddGPGKeyOptions represents the available AddGPGKey() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key
+type AddGPGKeyOptions struct {
+	Key *string `url:"key,omitempty" json:"key,omitempty"`
+}
+
+// AddGPGKey creates a new GPG key owned by the currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key
+func (s *UsersService) AddGPGKey(opt *AddGPGKeyOptions, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	req, err := s.client.NewRequest("POST", "user/gpg_keys", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// DeleteGPGKey deletes a GPG key owned by currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#delete-a-gpg-key
+func (s *UsersService) DeleteGPGKey(key int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("user/gpg_keys/%d", key)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListGPGKeysForUser gets a list of a specified user's GPG keys.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys-for-given-user
+func (s *UsersService) ListGPGKeysForUser(user int, options ...RequestOptionFunc) ([]*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys", user)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ks []*GPGKey
+	resp, err := s.client.Do(req, &ks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ks, resp, err
+}
+
+// GetGPGKeyForUser gets a specific GPG key for a given user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#get-a-specific-gpg-key-for-a-given-user
+func (s *UsersService) GetGPGKeyForUser(user, key int, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys/%d", user, key)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// AddGPGKeyForUser creates a new GPG key owned by the specified user.
+// Available only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key-for-a-given-user
+func (s *UsersService) AddGPGKeyForUser(user int, opt *AddGPGKeyOptions, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys", user)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// DeleteGPGKeyForUser deletes a GPG key owned by a specified user. Available
+// only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#delete-a-gpg-key-for-a-given-user
+func (s *UsersService) DeleteGPGKeyForUser(user, key int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys/%d", user, key)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // BlockUser blocks the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#block-user
-func (s *UsersService) BlockUser(user int, options ...RequestOptionFunc) error {
+func (s *UsersService) BlockUser(user int, options ...RequestOptionFunc) (*Response, error) {
 	u := fmt.Sprintf("users/%d/block", user)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil && resp == nil {
-		return err
+		return nil, err
 	}
 
 	switch resp.StatusCode {
 	case 201:
-		return nil
+		return resp, nil
 	case 403:
-		return ErrUserBlockPrevented
+		return resp, ErrUserBlockPrevented
 	case 404:
-		return ErrUserNotFound
+		return resp, ErrUserNotFound
 	default:
-		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
 	}
 }
 
 // UnblockUser unblocks the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#unblock-user
-func (s *UsersService) UnblockUser(user int, options ...RequestOptionFunc) error {
+func (s *UsersService) UnblockUser(user int, options ...RequestOptionFunc) (*Response, error) {
 	u := fmt.Sprintf("users/%d/unblock", user)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil && resp == nil {
-		return err
+		return nil, err
 	}
 
 	switch resp.StatusCode {
 	case 201:
-		return nil
+		return resp, nil
 	case 403:
-		return ErrUserUnblockPrevented
+		return resp, ErrUserUnblockPrevented
 	case 404:
-		return ErrUserNotFound
+		return resp, ErrUserNotFound
 	default:
-		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
 	}
 }
 
 // DeactivateUser deactivate the specified user. Available only for admin.
+// Deactivating a user preserves their history, but users with activity in
+// the past 90 days cannot be deactivated.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#deactivate-user
-func (s *UsersService) DeactivateUser(user int, options ...RequestOptionFunc) error {
+func (s *UsersService) DeactivateUser(user int, options ...RequestOptionFunc) (*Response, error) {
 	u := fmt.Sprintf("users/%d/deactivate", user)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil && resp == nil {
-		return err
+		return nil, err
 	}
 
 	switch resp.StatusCode {
 	case 201:
-		return nil
+		return resp, nil
 	case 403:
-		return ErrUserDeactivatePrevented
+		return resp, ErrUserDeactivatePrevented
 	case 404:
-		return ErrUserNotFound
+		return resp, ErrUserNotFound
 	default:
-		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
 	}
 }
 
 // ActivateUser activate the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#activate-user
-func (s *UsersService) ActivateUser(user int, options ...RequestOptionFunc) error {
+func (s *UsersService) ActivateUser(user int, options ...RequestOptionFunc) (*Response, error) {
 	u := fmt.Sprintf("users/%d/activate", user)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp == nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case 201:
+		return resp, nil
+	case 403:
+		return resp, ErrUserActivatePrevented
+	case 404:
+		return resp, ErrUserNotFound
+	default:
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+	}
+}
+
+// BanUser bans the specified user. Available only for admin.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#ban-user
+func (s *UsersService) BanUser(user int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/ban", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp == nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case 201:
+		return resp, nil
+	case 403:
+		return resp, ErrUserBanPrevented
+	case 404:
+		return resp, ErrUserNotFound
+	default:
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+	}
+}
+
+// UnbanUser unbans the specified user. Available only for admin.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#unban-user
+func (s *UsersService) UnbanUser(user int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/unban", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil && resp == nil {
-		return err
+		return nil, err
 	}
 
 	switch resp.StatusCode {
 	case 201:
-		return nil
+		return resp, nil
 	case 403:
-		return ErrUserActivatePrevented
+		return resp, ErrUserUnbanPrevented
+	case 404:
+		return resp, ErrUserNotFound
+	default:
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+	}
+}
+
+// ApproveUser approves the specified user that is pending approval. Available only for admin.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#approve-user
+func (s *UsersService) ApproveUser(user int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/approve", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp == nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case 201:
+		return resp, nil
+	case 403, 409:
+		return resp, ErrUserApprovePrevented
 	case 404:
-		return ErrUserNotFound
+		return resp, ErrUserNotFound
 	default:
-		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
 	}
 }
 
+// RejectUser rejects the specified user that is pending approval. Available
+// only for admin.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#reject-user
+func (s *UsersService) RejectUser(user int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/reject", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil && resp == nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case 200:
+		return resp, nil
+	case 404:
+		return resp, ErrUserNotFound
+	case 409:
+		return resp, ErrUserRejectPrevented
+	default:
+		return resp, fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
+	}
+}
+
+// FollowUser follows the specified user. If the user is already followed, the
+// status code 304 is returned together with a nil user and no error.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+func (s *UsersService) FollowUser(user int, options ...RequestOptionFunc) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d/follow", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usr := new(User)
+	resp, err := s.client.Do(req, usr)
+	if resp != nil && resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usr, resp, err
+}
+
+// UnfollowUser unfollows the specified user. If the user is not followed, the
+// status code 304 is returned together with a nil user and no error.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+func (s *UsersService) UnfollowUser(user int, options ...RequestOptionFunc) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d/unfollow", user)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usr := new(User)
+	resp, err := s.client.Do(req, usr)
+	if resp != nil && resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usr, resp, err
+}
+
+// ListFollowersOptions represents the available ListFollowers() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+type ListFollowersOptions ListOptions
+
+// ListFollowers gets a list of users following the specified user.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+func (s *UsersService) ListFollowers(user int, opt *ListFollowersOptions, options ...RequestOptionFunc) ([]*User, *Response, error) {
+	u := fmt.Sprintf("users/%d/followers", user)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usr []*User
+	resp, err := s.client.Do(req, &usr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usr, resp, err
+}
+
+// ListFollowingOptions represents the available ListFollowing() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+type ListFollowingOptions ListOptions
+
+// ListFollowing gets a list of users the specified user is following.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/users.html#follow-and-unfollow-users
+func (s *UsersService) ListFollowing(user int, opt *ListFollowingOptions, options ...RequestOptionFunc) ([]*User, *Response, error) {
+	u := fmt.Sprintf("users/%d/following", user)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usr []*User
+	resp, err := s.client.Do(req, &usr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usr, resp, err
+}
+
 // Email represents an Email.
 //
 // GitLab API docs: https://doc.gitlab.com/ce/api/users.html#list-emails
 type Email struct {
-	ID    int    `json:"id"`
-	Email string `json:"email"`
+	ID          int        `json:"id"`
+	Email       string     `json:"email"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
 }
 
 // ListEmails gets a list of currently authenticated user's Emails.
@@ -628,7 +1033,8 @@ func (s *UsersService) GetEmail(email int, options ...RequestOptionFunc) (*Email
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#add-email
 type AddEmailOptions struct {
-	Email *string `url:"email,omitempty" json:"email,omitempty"`
+	Email            *string `url:"email,omitempty" json:"email,omitempty"`
+	SkipConfirmation *bool   `url:"skip_confirmation,omitempty" json:"skip_confirmation,omitempty"`
 }
 
 // AddEmail creates a new email owned by the currently authenticated user.
@@ -854,14 +1260,59 @@ func (s *UsersService) GetUserActivities(opt *GetUserActivitiesOptions, options
 	return t, resp, err
 }
 
+// UserMembership represents a membership of the user in a namespace or project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-memberships-admin-only
+type UserMembership struct {
+	SourceID    int              `json:"source_id"`
+	SourceName  string           `json:"source_name"`
+	SourceType  string           `json:"source_type"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+// GetUserMembershipOptions represents the options available to query user
+// memberships. Type can be either "Project" or "Namespace".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-memberships-admin-only
+type GetUserMembershipOptions struct {
+	ListOptions
+	Type *string `url:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetUserMemberships retrieves a list of the user's memberships in all
+// projects and groups. Available only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-memberships-admin-only
+func (s *UsersService) GetUserMemberships(user int, opt *GetUserMembershipOptions, options ...RequestOptionFunc) ([]*UserMembership, *Response, error) {
+	u := fmt.Sprintf("users/%d/memberships", user)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m []*UserMembership
+	resp, err := s.client.Do(req, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
 // UserStatus represents the current status of a user
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#user-status
 type UserStatus struct {
-	Emoji       string `json:"emoji"`
-	Message     string `json:"message"`
-	MessageHTML string `json:"message_html"`
+	Emoji         string            `json:"emoji"`
+	Availability  AvailabilityValue `json:"availability"`
+	Message       string            `json:"message"`
+	MessageHTML   string            `json:"message_html"`
+	ClearStatusAt *time.Time        `json:"clear_status_at"`
 }
 
 // CurrentUserStatus retrieves the user status
@@ -883,12 +1334,17 @@ func (s *UsersService) CurrentUserStatus(options ...RequestOptionFunc) (*UserSta
 	return status, resp, err
 }
 
-// GetUserStatus retrieves a user's status
+// GetUserStatus retrieves a user's status. The user can be given either by
+// ID or by username.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#get-the-status-of-a-user
-func (s *UsersService) GetUserStatus(user int, options ...RequestOptionFunc) (*UserStatus, *Response, error) {
-	u := fmt.Sprintf("users/%d/status", user)
+func (s *UsersService) GetUserStatus(uid interface{}, options ...RequestOptionFunc) (*UserStatus, *Response, error) {
+	user, err := parseID(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("users/%s/status", pathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -909,11 +1365,14 @@ func (s *UsersService) GetUserStatus(user int, options ...RequestOptionFunc) (*U
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#set-user-status
 type UserStatusOptions struct {
-	Emoji   *string `url:"emoji,omitempty" json:"emoji,omitempty"`
-	Message *string `url:"message,omitempty" json:"message,omitempty"`
+	Emoji            *string                `url:"emoji,omitempty" json:"emoji,omitempty"`
+	Availability     *AvailabilityValue     `url:"availability,omitempty" json:"availability,omitempty"`
+	Message          *string                `url:"message,omitempty" json:"message,omitempty"`
+	ClearStatusAfter *ClearStatusAfterValue `url:"clear_status_after,omitempty" json:"clear_status_after,omitempty"`
 }
 
-// SetUserStatus sets the user's status
+// SetUserStatus sets the user's status. Leaving Emoji and Message empty
+// clears the status.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#set-user-status
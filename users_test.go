@@ -3,7 +3,9 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestBlockUser(t *testing.T) {
@@ -16,7 +18,7 @@ func TestBlockUser(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 	})
 
-	err := client.Users.BlockUser(1)
+	_, err := client.Users.BlockUser(1)
 	if err != nil {
 		t.Errorf("Users.BlockUser returned error: %v", err)
 	}
@@ -32,7 +34,7 @@ func TestBlockUser_UserNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.Users.BlockUser(1)
+	_, err := client.Users.BlockUser(1)
 	if err != ErrUserNotFound {
 		t.Errorf("Users.BlockUser error.\nExpected: %+v\nGot: %+v", ErrUserNotFound, err)
 	}
@@ -48,7 +50,7 @@ func TestBlockUser_BlockPrevented(t *testing.T) {
 		w.WriteHeader(http.StatusForbidden)
 	})
 
-	err := client.Users.BlockUser(1)
+	_, err := client.Users.BlockUser(1)
 	if err != ErrUserBlockPrevented {
 		t.Errorf("Users.BlockUser error.\nExpected: %+v\nGot: %+v", ErrUserBlockPrevented, err)
 	}
@@ -66,7 +68,7 @@ func TestBlockUser_UnknownError(t *testing.T) {
 
 	want := fmt.Sprintf("Received unexpected result code: %d", http.StatusTeapot)
 
-	err := client.Users.BlockUser(1)
+	_, err := client.Users.BlockUser(1)
 	if err.Error() != want {
 		t.Errorf("Users.BlockUser error.\nExpected: %s\nGot: %v", want, err)
 	}
@@ -83,7 +85,7 @@ func TestUnblockUser(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 	})
 
-	err := client.Users.UnblockUser(1)
+	_, err := client.Users.UnblockUser(1)
 	if err != nil {
 		t.Errorf("Users.UnblockUser returned error: %v", err)
 	}
@@ -99,7 +101,7 @@ func TestUnblockUser_UserNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.Users.UnblockUser(1)
+	_, err := client.Users.UnblockUser(1)
 	if err != ErrUserNotFound {
 		t.Errorf("Users.UnblockUser error.\nExpected: %v\nGot: %v", ErrUserNotFound, err)
 	}
@@ -115,7 +117,7 @@ func TestUnblockUser_UnblockPrevented(t *testing.T) {
 		w.WriteHeader(http.StatusForbidden)
 	})
 
-	err := client.Users.UnblockUser(1)
+	_, err := client.Users.UnblockUser(1)
 	if err != ErrUserUnblockPrevented {
 		t.Errorf("Users.UnblockUser error.\nExpected: %v\nGot: %v", ErrUserUnblockPrevented, err)
 	}
@@ -133,7 +135,7 @@ func TestUnblockUser_UnknownError(t *testing.T) {
 
 	want := fmt.Sprintf("Received unexpected result code: %d", http.StatusTeapot)
 
-	err := client.Users.UnblockUser(1)
+	_, err := client.Users.UnblockUser(1)
 	if err.Error() != want {
 		t.Errorf("Users.UnblockUser error.\nExpected: %s\n\tGot: %v", want, err)
 	}
@@ -149,7 +151,7 @@ func TestDeactivateUser(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 	})
 
-	err := client.Users.DeactivateUser(1)
+	_, err := client.Users.DeactivateUser(1)
 	if err != nil {
 		t.Errorf("Users.DeactivateUser returned error: %v", err)
 	}
@@ -165,7 +167,7 @@ func TestDeactivateUser_UserNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.Users.DeactivateUser(1)
+	_, err := client.Users.DeactivateUser(1)
 	if err != ErrUserNotFound {
 		t.Errorf("Users.DeactivateUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
 	}
@@ -181,7 +183,7 @@ func TestDeactivateUser_DeactivatePrevented(t *testing.T) {
 		w.WriteHeader(http.StatusForbidden)
 	})
 
-	err := client.Users.DeactivateUser(1)
+	_, err := client.Users.DeactivateUser(1)
 	if err != ErrUserDeactivatePrevented {
 		t.Errorf("Users.DeactivateUser error.\nExpected: %+v\n\tGot: %+v", ErrUserDeactivatePrevented, err)
 	}
@@ -197,7 +199,7 @@ func TestActivateUser(t *testing.T) {
 		w.WriteHeader(http.StatusCreated)
 	})
 
-	err := client.Users.ActivateUser(1)
+	_, err := client.Users.ActivateUser(1)
 	if err != nil {
 		t.Errorf("Users.ActivateUser returned error: %v", err)
 	}
@@ -213,7 +215,7 @@ func TestActivateUser_ActivatePrevented(t *testing.T) {
 		w.WriteHeader(http.StatusForbidden)
 	})
 
-	err := client.Users.ActivateUser(1)
+	_, err := client.Users.ActivateUser(1)
 	if err != ErrUserActivatePrevented {
 		t.Errorf("Users.ActivateUser error.\nExpected: %+v\n\tGot: %+v", ErrUserActivatePrevented, err)
 	}
@@ -229,8 +231,730 @@ func TestActivateUser_UserNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.Users.ActivateUser(1)
+	_, err := client.Users.ActivateUser(1)
 	if err != ErrUserNotFound {
 		t.Errorf("Users.ActivateUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
 	}
 }
+
+func TestCreateUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"email":"john@example.com","force_random_password":true,"username":"john","name":"John Smith","extern_uid":"cn=john,ou=people","provider":"ldapmain","skip_confirmation":true,"external":true,"note":"Contractor"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"username": "john",
+			"name": "John Smith",
+			"state": "active",
+			"is_admin": false,
+			"external": true,
+			"two_factor_enabled": false,
+			"note": "Contractor",
+			"using_license_seat": true,
+			"last_sign_in_at": "2012-06-01T11:41:01Z",
+			"last_activity_on": "2012-05-23",
+			"identities": [{"provider": "ldapmain", "extern_uid": "cn=john,ou=people"}]
+		}`)
+	})
+
+	opt := &CreateUserOptions{
+		Email:               String("john@example.com"),
+		ForceRandomPassword: Bool(true),
+		Username:            String("john"),
+		Name:                String("John Smith"),
+		ExternUID:           String("cn=john,ou=people"),
+		Provider:            String("ldapmain"),
+		SkipConfirmation:    Bool(true),
+		External:            Bool(true),
+		Note:                String("Contractor"),
+	}
+	user, _, err := client.Users.CreateUser(opt)
+	if err != nil {
+		t.Fatalf("Users.CreateUser returned error: %v", err)
+	}
+
+	lastSignInAt := time.Date(2012, time.June, 1, 11, 41, 1, 0, time.UTC)
+	lastActivityOn := ISOTime(time.Date(2012, time.May, 23, 0, 0, 0, 0, time.UTC))
+	want := &User{
+		ID:               1,
+		Username:         "john",
+		Name:             "John Smith",
+		State:            "active",
+		External:         true,
+		Note:             "Contractor",
+		UsingLicenseSeat: true,
+		LastSignInAt:     &lastSignInAt,
+		LastActivityOn:   &lastActivityOn,
+		Identities:       []*UserIdentity{{Provider: "ldapmain", ExternUID: "cn=john,ou=people"}},
+	}
+	if !reflect.DeepEqual(want, user) {
+		t.Errorf("Users.CreateUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestModifyUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"projects_limit":10,"admin":true,"can_create_group":false,"note":"Promoted","color_scheme_id":2,"shared_runners_minutes_limit":400}`)
+		fmt.Fprint(w, `{"id": 1, "is_admin": true, "projects_limit": 10, "note": "Promoted", "color_scheme_id": 2, "shared_runners_minutes_limit": 400}`)
+	})
+
+	opt := &ModifyUserOptions{
+		ProjectsLimit:             Int(10),
+		Admin:                     Bool(true),
+		CanCreateGroup:            Bool(false),
+		Note:                      String("Promoted"),
+		ColorSchemeID:             Int(2),
+		SharedRunnersMinutesLimit: Int(400),
+	}
+	user, _, err := client.Users.ModifyUser(1, opt)
+	if err != nil {
+		t.Fatalf("Users.ModifyUser returned error: %v", err)
+	}
+
+	want := &User{ID: 1, IsAdmin: true, ProjectsLimit: 10, Note: "Promoted", ColorSchemeID: 2, SharedRunnersMinutesLimit: 400}
+	if !reflect.DeepEqual(want, user) {
+		t.Errorf("Users.ModifyUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestBanUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/ban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Users.BanUser(1)
+	if err != nil {
+		t.Errorf("Users.BanUser returned error: %v", err)
+	}
+}
+
+func TestBanUser_BanPrevented(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/ban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Users.BanUser(1)
+	if err != ErrUserBanPrevented {
+		t.Errorf("Users.BanUser error.\nExpected: %+v\n\tGot: %+v", ErrUserBanPrevented, err)
+	}
+}
+
+func TestBanUser_UserNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/ban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Users.BanUser(1)
+	if err != ErrUserNotFound {
+		t.Errorf("Users.BanUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
+	}
+}
+
+func TestUnbanUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/unban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Users.UnbanUser(1)
+	if err != nil {
+		t.Errorf("Users.UnbanUser returned error: %v", err)
+	}
+}
+
+func TestUnbanUser_UnbanPrevented(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/unban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Users.UnbanUser(1)
+	if err != ErrUserUnbanPrevented {
+		t.Errorf("Users.UnbanUser error.\nExpected: %+v\n\tGot: %+v", ErrUserUnbanPrevented, err)
+	}
+}
+
+func TestUnbanUser_UserNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/unban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Users.UnbanUser(1)
+	if err != ErrUserNotFound {
+		t.Errorf("Users.UnbanUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
+	}
+}
+
+func TestApproveUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/approve", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Users.ApproveUser(1)
+	if err != nil {
+		t.Errorf("Users.ApproveUser returned error: %v", err)
+	}
+}
+
+func TestApproveUser_ApprovePrevented(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/approve", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Users.ApproveUser(1)
+	if err != ErrUserApprovePrevented {
+		t.Errorf("Users.ApproveUser error.\nExpected: %+v\n\tGot: %+v", ErrUserApprovePrevented, err)
+	}
+}
+
+func TestApproveUser_UserNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/approve", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Users.ApproveUser(1)
+	if err != ErrUserNotFound {
+		t.Errorf("Users.ApproveUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
+	}
+}
+
+func TestApproveUser_Conflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/approve", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, err := client.Users.ApproveUser(1)
+	if err != ErrUserApprovePrevented {
+		t.Errorf("Users.ApproveUser error.\nExpected: %+v\n\tGot: %+v", ErrUserApprovePrevented, err)
+	}
+}
+
+func TestRejectUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/reject", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := client.Users.RejectUser(1)
+	if err != nil {
+		t.Errorf("Users.RejectUser returned error: %v", err)
+	}
+}
+
+func TestBanUser_ReturnsResponse(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/ban", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	resp, err := client.Users.BanUser(1)
+	if err != ErrUserBanPrevented {
+		t.Errorf("Users.BanUser error.\nExpected: %+v\n\tGot: %+v", ErrUserBanPrevented, err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Users.BanUser returned response %+v, want status %d", resp, http.StatusForbidden)
+	}
+}
+
+func TestRejectUser_RejectPrevented(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/reject", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, err := client.Users.RejectUser(1)
+	if err != ErrUserRejectPrevented {
+		t.Errorf("Users.RejectUser error.\nExpected: %+v\n\tGot: %+v", ErrUserRejectPrevented, err)
+	}
+}
+
+func TestListPendingApprovalUsers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users?pending_approval=true")
+		fmt.Fprint(w, `[{"id":7,"username":"jane","email":"jane@example.com","state":"blocked_pending_approval"}]`)
+	})
+
+	users, _, err := client.Users.ListUsers(&ListUsersOptions{PendingApproval: Bool(true)})
+	if err != nil {
+		t.Fatalf("Users.ListUsers returned error: %v", err)
+	}
+
+	want := []*User{{ID: 7, Username: "jane", Email: "jane@example.com", State: "blocked_pending_approval"}}
+	if !reflect.DeepEqual(want, users) {
+		t.Errorf("Users.ListUsers returned %+v, want %+v", users, want)
+	}
+}
+
+func TestListSSHKeysForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/1/keys?page=2&per_page=20")
+		fmt.Fprint(w, `[{"id": 1, "title": "laptop", "key": "ssh-ed25519 AAAA", "created_at": "2020-01-01T10:00:00Z", "expires_at": "2021-01-01T00:00:00Z"}]`)
+	})
+
+	keys, _, err := client.Users.ListSSHKeysForUser(1, &ListSSHKeysForUserOptions{Page: 2, PerPage: 20})
+	if err != nil {
+		t.Fatalf("Users.ListSSHKeysForUser returned error: %v", err)
+	}
+
+	createdAt := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	want := []*SSHKey{{ID: 1, Title: "laptop", Key: "ssh-ed25519 AAAA", CreatedAt: &createdAt, ExpiresAt: &expiresAt}}
+	if !reflect.DeepEqual(want, keys) {
+		t.Errorf("Users.ListSSHKeysForUser returned %+v, want %+v", keys, want)
+	}
+}
+
+func TestAddSSHKeyForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"laptop","key":"ssh-ed25519 AAAA","expires_at":"2021-01-01T00:00:00Z"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 1, "title": "laptop", "key": "ssh-ed25519 AAAA", "expires_at": "2021-01-01T00:00:00Z"}`)
+	})
+
+	expiresAt := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt := &AddSSHKeyOptions{
+		Title:     String("laptop"),
+		Key:       String("ssh-ed25519 AAAA"),
+		ExpiresAt: &expiresAt,
+	}
+	key, _, err := client.Users.AddSSHKeyForUser(1, opt)
+	if err != nil {
+		t.Fatalf("Users.AddSSHKeyForUser returned error: %v", err)
+	}
+
+	want := &SSHKey{ID: 1, Title: "laptop", Key: "ssh-ed25519 AAAA", ExpiresAt: &expiresAt}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Users.AddSSHKeyForUser returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDeleteSSHKeyForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/keys/2", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.DeleteSSHKeyForUser(1, 2)
+	if err != nil {
+		t.Errorf("Users.DeleteSSHKeyForUser returned error: %v", err)
+	}
+}
+
+func TestAddGPGKeyForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	armored := "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nxsBNBFV+\n=Rar3\n-----END PGP PUBLIC KEY BLOCK-----"
+
+	path := fmt.Sprintf("/%susers/1/gpg_keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nxsBNBFV+\n=Rar3\n-----END PGP PUBLIC KEY BLOCK-----"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 1, "key": "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nxsBNBFV+\n=Rar3\n-----END PGP PUBLIC KEY BLOCK-----", "created_at": "2017-09-05T09:17:46Z"}`)
+	})
+
+	key, _, err := client.Users.AddGPGKeyForUser(1, &AddGPGKeyOptions{Key: String(armored)})
+	if err != nil {
+		t.Fatalf("Users.AddGPGKeyForUser returned error: %v", err)
+	}
+
+	createdAt := time.Date(2017, time.September, 5, 9, 17, 46, 0, time.UTC)
+	want := &GPGKey{ID: 1, Key: armored, CreatedAt: &createdAt}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Users.AddGPGKeyForUser returned %+v, want %+v", key, want)
+	}
+}
+
+func TestListGPGKeys(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/gpg_keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "key": "-----BEGIN PGP PUBLIC KEY BLOCK-----"}]`)
+	})
+
+	keys, _, err := client.Users.ListGPGKeys()
+	if err != nil {
+		t.Fatalf("Users.ListGPGKeys returned error: %v", err)
+	}
+
+	want := []*GPGKey{{ID: 1, Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----"}}
+	if !reflect.DeepEqual(want, keys) {
+		t.Errorf("Users.ListGPGKeys returned %+v, want %+v", keys, want)
+	}
+}
+
+func TestDeleteGPGKeyForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/gpg_keys/2", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.DeleteGPGKeyForUser(1, 2)
+	if err != nil {
+		t.Errorf("Users.DeleteGPGKeyForUser returned error: %v", err)
+	}
+}
+
+func TestAddEmailForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/emails", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"email":"john@new.example.com","skip_confirmation":true}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 4, "email": "john@new.example.com", "confirmed_at": "2021-03-26T19:07:56Z"}`)
+	})
+
+	opt := &AddEmailOptions{
+		Email:            String("john@new.example.com"),
+		SkipConfirmation: Bool(true),
+	}
+	email, _, err := client.Users.AddEmailForUser(1, opt)
+	if err != nil {
+		t.Fatalf("Users.AddEmailForUser returned error: %v", err)
+	}
+
+	confirmedAt := time.Date(2021, time.March, 26, 19, 7, 56, 0, time.UTC)
+	want := &Email{ID: 4, Email: "john@new.example.com", ConfirmedAt: &confirmedAt}
+	if !reflect.DeepEqual(want, email) {
+		t.Errorf("Users.AddEmailForUser returned %+v, want %+v", email, want)
+	}
+}
+
+func TestListEmailsForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/emails", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "email": "john@old.example.com", "confirmed_at": null}]`)
+	})
+
+	emails, _, err := client.Users.ListEmailsForUser(1, nil)
+	if err != nil {
+		t.Fatalf("Users.ListEmailsForUser returned error: %v", err)
+	}
+
+	want := []*Email{{ID: 1, Email: "john@old.example.com"}}
+	if !reflect.DeepEqual(want, emails) {
+		t.Errorf("Users.ListEmailsForUser returned %+v, want %+v", emails, want)
+	}
+}
+
+func TestDeleteEmailForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/emails/1", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.DeleteEmailForUser(1, 1)
+	if err != nil {
+		t.Errorf("Users.DeleteEmailForUser returned error: %v", err)
+	}
+}
+
+func TestGetUserMemberships(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/memberships", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/1/memberships?page=1&per_page=100&type=Project")
+		fmt.Fprint(w, `[{"source_id": 1, "source_name": "Project one", "source_type": "Project", "access_level": 20}]`)
+	})
+
+	opt := &GetUserMembershipOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 100},
+		Type:        String("Project"),
+	}
+	memberships, _, err := client.Users.GetUserMemberships(1, opt)
+	if err != nil {
+		t.Fatalf("Users.GetUserMemberships returned error: %v", err)
+	}
+
+	want := []*UserMembership{{SourceID: 1, SourceName: "Project one", SourceType: "Project", AccessLevel: ReporterPermissions}}
+	if !reflect.DeepEqual(want, memberships) {
+		t.Errorf("Users.GetUserMemberships returned %+v, want %+v", memberships, want)
+	}
+}
+
+func TestGetUserStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/john/status", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"emoji": "pager", "availability": "busy", "message": "On call until Friday", "message_html": "On call until Friday", "clear_status_at": "2021-03-26T16:00:00Z"}`)
+	})
+
+	status, _, err := client.Users.GetUserStatus("john")
+	if err != nil {
+		t.Fatalf("Users.GetUserStatus returned error: %v", err)
+	}
+
+	clearStatusAt := time.Date(2021, time.March, 26, 16, 0, 0, 0, time.UTC)
+	want := &UserStatus{
+		Emoji:         "pager",
+		Availability:  Busy,
+		Message:       "On call until Friday",
+		MessageHTML:   "On call until Friday",
+		ClearStatusAt: &clearStatusAt,
+	}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("Users.GetUserStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestSetUserStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/status", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"emoji":"pager","availability":"busy","message":"On call until Friday","clear_status_after":"3_days"}`)
+		fmt.Fprint(w, `{"emoji": "pager", "availability": "busy", "message": "On call until Friday"}`)
+	})
+
+	opt := &UserStatusOptions{
+		Emoji:            String("pager"),
+		Availability:     Availability(Busy),
+		Message:          String("On call until Friday"),
+		ClearStatusAfter: ClearStatusAfter(ClearStatusAfter3Days),
+	}
+	status, _, err := client.Users.SetUserStatus(opt)
+	if err != nil {
+		t.Fatalf("Users.SetUserStatus returned error: %v", err)
+	}
+
+	want := &UserStatus{Emoji: "pager", Availability: Busy, Message: "On call until Friday"}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("Users.SetUserStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestListUsersWithAdminFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users?active=true&exclude_internal=true&per_page=1&two_factor=disabled&without_project_bots=true")
+		w.Header().Set("X-Total", "1234")
+		fmt.Fprint(w, `[{"id": 1, "username": "john"}]`)
+	})
+
+	opt := &ListUsersOptions{
+		ListOptions:        ListOptions{PerPage: 1},
+		Active:             Bool(true),
+		ExcludeInternal:    Bool(true),
+		WithoutProjectBots: Bool(true),
+		TwoFactor:          String("disabled"),
+	}
+	users, resp, err := client.Users.ListUsers(opt)
+	if err != nil {
+		t.Fatalf("Users.ListUsers returned error: %v", err)
+	}
+
+	want := []*User{{ID: 1, Username: "john"}}
+	if !reflect.DeepEqual(want, users) {
+		t.Errorf("Users.ListUsers returned %+v, want %+v", users, want)
+	}
+	if resp.TotalItems != 1234 {
+		t.Errorf("Users.ListUsers returned TotalItems %d, want %d", resp.TotalItems, 1234)
+	}
+}
+
+func TestFollowUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/follow", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 2, "username": "jane"}`)
+	})
+
+	user, _, err := client.Users.FollowUser(2)
+	if err != nil {
+		t.Fatalf("Users.FollowUser returned error: %v", err)
+	}
+
+	want := &User{ID: 2, Username: "jane"}
+	if !reflect.DeepEqual(want, user) {
+		t.Errorf("Users.FollowUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestFollowUser_AlreadyFollowing(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/follow", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	user, resp, err := client.Users.FollowUser(2)
+	if err != nil {
+		t.Fatalf("Users.FollowUser returned error: %v", err)
+	}
+	if user != nil {
+		t.Errorf("Users.FollowUser returned %+v, want nil", user)
+	}
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Users.FollowUser returned status %d, want %d", resp.StatusCode, http.StatusNotModified)
+	}
+}
+
+func TestListFollowers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/followers", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/1/followers?page=1&per_page=50")
+		fmt.Fprint(w, `[{"id": 2, "username": "jane"}]`)
+	})
+
+	users, _, err := client.Users.ListFollowers(1, &ListFollowersOptions{Page: 1, PerPage: 50})
+	if err != nil {
+		t.Fatalf("Users.ListFollowers returned error: %v", err)
+	}
+
+	want := []*User{{ID: 2, Username: "jane"}}
+	if !reflect.DeepEqual(want, users) {
+		t.Errorf("Users.ListFollowers returned %+v, want %+v", users, want)
+	}
+}
+
+func TestListFollowing(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/1/following", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 3, "username": "joe"}]`)
+	})
+
+	users, _, err := client.Users.ListFollowing(1, nil)
+	if err != nil {
+		t.Fatalf("Users.ListFollowing returned error: %v", err)
+	}
+
+	want := []*User{{ID: 3, Username: "joe"}}
+	if !reflect.DeepEqual(want, users) {
+		t.Errorf("Users.ListFollowing returned %+v, want %+v", users, want)
+	}
+}